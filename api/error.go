@@ -0,0 +1,81 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Error is returned when Telegram rejects a request.
+type Error struct {
+	// Error code, mirrors the HTTP status code of the response
+	Code int
+
+	// Human-readable description of the error
+	Description string
+
+	// Additional information about the error,
+	// e.g. how long to wait before retrying
+	Parameters ResponseParameters
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("telegram API error: code %d - %s", e.Code, e.Description)
+}
+
+// Descriptions of errors returned by message editing methods
+const (
+	descriptionNotModified      = "message is not modified"
+	descriptionToEditNotFound   = "message to edit not found"
+	descriptionCantBeEdited     = "message can't be edited"
+	descriptionMessageIDInvalid = "MESSAGE_ID_INVALID"
+)
+
+// IsMessageNotModified reports whether err means that an edit
+// request specified the content the message already has.
+func IsMessageNotModified(err error) bool {
+	return hasDescription(err, descriptionNotModified)
+}
+
+// IsMessageNotEditable reports whether err means that the message
+// can no longer be edited, because it was deleted or is too old.
+func IsMessageNotEditable(err error) bool {
+	return hasDescription(err, descriptionToEditNotFound) ||
+		hasDescription(err, descriptionCantBeEdited) ||
+		hasDescription(err, descriptionMessageIDInvalid)
+}
+
+// RetryAfter returns how long to wait before repeating a request
+// rejected by flood control, or zero if err isn't such an error.
+func RetryAfter(err error) time.Duration {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return 0
+	}
+
+	return time.Duration(apiErr.Parameters.RetryAfter) * time.Second
+}
+
+// IsBadRequest reports whether err means that Telegram rejected
+// the request itself, so repeating it as is won't help.
+func IsBadRequest(err error) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.Code >= http.StatusBadRequest &&
+		apiErr.Code < http.StatusInternalServerError &&
+		apiErr.Code != http.StatusTooManyRequests
+}
+
+func hasDescription(err error, description string) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return strings.Contains(apiErr.Description, description)
+}
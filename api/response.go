@@ -3,7 +3,13 @@ package api
 import "github.com/purkhanov/gogram/types"
 
 type ResponseType interface {
-	[]types.Update | types.Message | bool | string
+	[]types.Update | types.Message | bool | string |
+		types.ChatMember | []types.ChatMember |
+		types.MessageId | []types.MessageId |
+		types.EditedMessage | types.Poll | types.User |
+		types.BotName | types.BotDescription | types.BotShortDescription |
+		[]types.BotCommand | types.ChatFullInfo | int |
+		types.ChatInviteLink | types.ForumTopic | []types.Sticker
 }
 
 type APIResponse[T ResponseType] struct {
@@ -11,10 +17,11 @@ type APIResponse[T ResponseType] struct {
 	Result      T                  `json:"result"`
 	Description string             `json:"description"`
 	ErrorCode   int                `json:"error_code"`
-	Parameters  responseParameters `json:"parameters"`
+	Parameters  ResponseParameters `json:"parameters"`
 }
 
-type responseParameters struct {
+// Describes why a request was unsuccessful.
+type ResponseParameters struct {
 	// Optional. The group has been migrated to a supergroup
 	// with the specified identifier. This number may have more
 	// than 32 significant bits and some programming languages
@@ -22,11 +29,24 @@ type responseParameters struct {
 	// But it has at most 52 significant bits, so a signed
 	// 64-bit integer or double-precision float type are safe
 	// for storing this identifier.
-	MigrateToChatID int `json:"migrate_to_chat_id,omitempty"`
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
 
 	// Optional. In case of exceeding flood control, the number of
 	// seconds left to wait before the request can be repeated
-	Retry_After int `json:"retry_after,omitempty"`
+	RetryAfter int `json:"retry_after,omitempty"`
+}
+
+// Err returns the error described by an unsuccessful response.
+func (r APIResponse[T]) Err() error {
+	if r.Ok {
+		return nil
+	}
+
+	return &Error{
+		Code:        r.ErrorCode,
+		Description: r.Description,
+		Parameters:  r.Parameters,
+	}
 }
 
 type ApiResponse struct {
@@ -2,11 +2,14 @@ package bot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/purkhanov/gogram/api"
+	"github.com/purkhanov/gogram/types"
 )
 
 const (
@@ -18,6 +21,10 @@ type Bot struct {
 	urlWithToken string
 	api          *api.ApiClient
 	ctx          context.Context
+
+	// The result of getMe, fetched on first use
+	me   *types.User
+	meMu sync.Mutex
 }
 
 func NewBot(ctx context.Context, token string) *Bot {
@@ -31,3 +38,65 @@ func NewBot(ctx context.Context, token string) *Bot {
 		ctx:          ctx,
 	}
 }
+
+// request marshals params, calls the given API method
+// and decodes the result of a successful response.
+// Requests rejected by Telegram return an *api.Error.
+func request[T api.ResponseType](b *Bot, methodUrl string, params any) (T, error) {
+	var zero T
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return zero, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	c, cancel := context.WithTimeout(b.ctx, httpRequestTimeout)
+	defer cancel()
+
+	resp, err := b.api.DoRequestWithContextAndData(
+		c, http.MethodPost, b.urlWithToken+methodUrl, data,
+	)
+
+	return decodeResponse[T](resp, err)
+}
+
+// decodeResponse decodes the result of a request. err is the
+// error returned by the API client along with the response body.
+func decodeResponse[T api.ResponseType](resp []byte, err error) (T, error) {
+	var zero T
+
+	if err != nil && len(resp) == 0 {
+		return zero, err
+	}
+
+	var result api.APIResponse[T]
+
+	if jsonErr := json.Unmarshal(resp, &result); jsonErr != nil {
+		// Telegram describes failed requests in the body,
+		// so the status error is only returned without one.
+		if err != nil {
+			return zero, err
+		}
+
+		return zero, fmt.Errorf("failed to unmarshal response: %w", jsonErr)
+	}
+
+	if !result.Ok {
+		return zero, result.Err()
+	}
+
+	if err != nil {
+		return zero, err
+	}
+
+	return result.Result, nil
+}
+
+// unixDate converts a date to Unix time, the zero time to 0.
+func unixDate(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
@@ -0,0 +1,98 @@
+package bot
+
+import (
+	"context"
+	"time"
+
+	"github.com/purkhanov/gogram/api"
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const (
+	sendChatActionUrl = "/sendChatAction"
+
+	// The status is shown for 5 seconds or less,
+	// so it's repeated a bit earlier
+	chatActionInterval = 4 * time.Second
+)
+
+type SendChatActionOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the action will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// Unique identifier for the target chat or username of the
+	// target supergroup (in the format @supergroupusername).
+	// Channel chats and channel direct messages chats aren't supported.
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread;
+	// for supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Type of action to broadcast, one of the ChatAction constants
+	Action types.ChatAction `json:"action" validate:"required"`
+}
+
+// Use this method when you need to tell the user that something is
+// happening on the bot's side. The status is set for 5 seconds or less
+// (when a message arrives from your bot, Telegram clients clear its
+// typing status). Returns True on success.
+//
+// Use KeepChatAction for operations that take longer.
+func (b *Bot) SendChatAction(options SendChatActionOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, sendChatActionUrl, options)
+	return err
+}
+
+// KeepChatAction sends the chat action and repeats it every
+// 4 seconds until stop is called or ctx is done. Only the error
+// of the first action is returned. Repeating stops early if Telegram
+// rejects an action, e.g. the bot was blocked or removed from the
+// chat, and is paused while flood control is in effect.
+//
+//	stop, err := b.KeepChatAction(ctx, options)
+//	if err == nil {
+//		defer stop()
+//	}
+func (b *Bot) KeepChatAction(ctx context.Context, options SendChatActionOptions) (stop func(), err error) {
+	if err := b.SendChatAction(options); err != nil {
+		return func() {}, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		ticker := time.NewTicker(chatActionInterval)
+		defer ticker.Stop()
+
+		// Actions are paused until then by flood control
+		var resume time.Time
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-ticker.C:
+				if time.Now().Before(resume) {
+					continue
+				}
+
+				err := b.SendChatAction(options)
+				if wait := api.RetryAfter(err); wait > 0 {
+					resume = time.Now().Add(wait)
+				} else if api.IsBadRequest(err) {
+					return
+				}
+			}
+		}
+	}()
+
+	return cancel, nil
+}
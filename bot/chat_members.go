@@ -0,0 +1,31 @@
+package bot
+
+import (
+	"github.com/purkhanov/gogram/types"
+)
+
+const (
+	getChatMemberUrl         = "/getChatMember"
+	getChatAdministratorsUrl = "/getChatAdministrators"
+)
+
+// Use this method to get information about a member of a chat.
+// The method is only guaranteed to work for other users if the
+// bot is an administrator in the chat. Returns a ChatMember
+// object on success.
+func (b *Bot) GetChatMember(chatID types.ChatID, userID int64) (types.ChatMember, error) {
+	params := map[string]any{
+		"chat_id": chatID,
+		"user_id": userID,
+	}
+
+	return request[types.ChatMember](b, getChatMemberUrl, params)
+}
+
+// Use this method to get a list of administrators in a chat,
+// which aren't bots. Returns an Array of ChatMember objects.
+func (b *Bot) GetChatAdministrators(chatID types.ChatID) ([]types.ChatMember, error) {
+	params := map[string]any{"chat_id": chatID}
+
+	return request[[]types.ChatMember](b, getChatAdministratorsUrl, params)
+}
@@ -0,0 +1,294 @@
+package bot
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const (
+	banChatMemberUrl                   = "/banChatMember"
+	unbanChatMemberUrl                 = "/unbanChatMember"
+	restrictChatMemberUrl              = "/restrictChatMember"
+	promoteChatMemberUrl               = "/promoteChatMember"
+	setChatAdministratorCustomTitleUrl = "/setChatAdministratorCustomTitle"
+	banChatSenderChatUrl               = "/banChatSenderChat"
+	unbanChatSenderChatUrl             = "/unbanChatSenderChat"
+	setChatPermissionsUrl              = "/setChatPermissions"
+)
+
+type BanChatMemberOptions struct {
+	// Unique identifier for the target group or username of the
+	// target supergroup or channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id" validate:"required"`
+
+	// Date when the user will be unbanned. If user is banned for
+	// more than 366 days or less than 30 seconds from the current
+	// time they are considered to be banned forever. Applied for
+	// supergroups and channels only. Zero time bans forever.
+	UntilDate time.Time `json:"-"`
+
+	// Pass True to delete all messages from the chat for the user
+	// that is being removed. If False, the user will be able to see
+	// messages in the group that were sent before the user was
+	// removed. Always True for supergroups and channels.
+	RevokeMessages bool `json:"revoke_messages,omitempty"`
+}
+
+func (o BanChatMemberOptions) MarshalJSON() ([]byte, error) {
+	type options BanChatMemberOptions
+
+	return json.Marshal(struct {
+		options
+		UntilDate int64 `json:"until_date,omitempty"`
+	}{options(o), unixDate(o.UntilDate)})
+}
+
+// Use this method to ban a user in a group, a supergroup or a channel.
+// In the case of supergroups and channels, the user will not be able
+// to return to the chat on their own using invite links, etc., unless
+// unbanned first. The bot must be an administrator in the chat for
+// this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func (b *Bot) BanChatMember(options BanChatMemberOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, banChatMemberUrl, options)
+	return err
+}
+
+type UnbanChatMemberOptions struct {
+	// Unique identifier for the target group or username of the
+	// target supergroup or channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id" validate:"required"`
+
+	// Do nothing if the user is not banned
+	OnlyIfBanned bool `json:"only_if_banned,omitempty"`
+}
+
+// Use this method to unban a previously banned user in a supergroup
+// or channel. The user will not return to the group or channel
+// automatically, but will be able to join via link, etc. The bot must
+// be an administrator for this to work. By default, this method
+// guarantees that after the call the user is not a member of the chat,
+// but will be able to join it. So if the user is a member of the chat
+// they will also be removed from the chat. If you don't want this,
+// use the parameter only_if_banned. Returns True on success.
+func (b *Bot) UnbanChatMember(options UnbanChatMemberOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, unbanChatMemberUrl, options)
+	return err
+}
+
+type RestrictChatMemberOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target supergroup (in the format @supergroupusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id" validate:"required"`
+
+	// A JSON-serialized object for new user permissions
+	Permissions types.ChatPermissions `json:"permissions"`
+
+	// Pass True if chat permissions are set independently. Otherwise,
+	// the can_send_other_messages and can_add_web_page_previews
+	// permissions will imply the can_send_messages, can_send_audios,
+	// can_send_documents, can_send_photos, can_send_videos,
+	// can_send_video_notes, and can_send_voice_notes permissions;
+	// the can_send_polls permission will imply the can_send_messages
+	// permission.
+	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
+
+	// Date when restrictions will be lifted for the user. If user is
+	// restricted for more than 366 days or less than 30 seconds from
+	// the current time, they are considered to be restricted forever.
+	// Zero time restricts forever.
+	UntilDate time.Time `json:"-"`
+}
+
+func (o RestrictChatMemberOptions) MarshalJSON() ([]byte, error) {
+	type options RestrictChatMemberOptions
+
+	return json.Marshal(struct {
+		options
+		UntilDate int64 `json:"until_date,omitempty"`
+	}{options(o), unixDate(o.UntilDate)})
+}
+
+// Use this method to restrict a user in a supergroup. The bot must be
+// an administrator in the supergroup for this to work and must have
+// the appropriate administrator rights. Pass True for all permissions
+// to lift restrictions from a user. Returns True on success.
+func (b *Bot) RestrictChatMember(options RestrictChatMemberOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, restrictChatMemberUrl, options)
+	return err
+}
+
+type PromoteChatMemberOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id" validate:"required"`
+
+	// Rights to grant to the user. Pass no rights to demote the user
+	types.ChatAdministratorRights
+}
+
+// Use this method to promote or demote a user in a supergroup or a
+// channel. The bot must be an administrator in the chat for this to
+// work and must have the appropriate administrator rights. Pass False
+// for all boolean parameters to demote a user. Returns True on success.
+func (b *Bot) PromoteChatMember(options PromoteChatMemberOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, promoteChatMemberUrl, options)
+	return err
+}
+
+// Use this method to set a custom title for an administrator in a
+// supergroup promoted by the bot. The title is 0-16 characters, emoji
+// are not allowed. Returns True on success.
+func (b *Bot) SetChatAdministratorCustomTitle(
+	chatID types.ChatID, userID int64, customTitle string,
+) error {
+	if err := checkLength("custom title", customTitle, maxCustomTitleLength); err != nil {
+		return err
+	}
+
+	params := map[string]any{
+		"chat_id":      chatID,
+		"user_id":      userID,
+		"custom_title": customTitle,
+	}
+
+	_, err := request[bool](b, setChatAdministratorCustomTitleUrl, params)
+	return err
+}
+
+// Use this method to ban a channel chat in a supergroup or a channel.
+// Until the chat is unbanned, the owner of the banned chat won't be
+// able to send messages on behalf of any of their channels. The bot
+// must be an administrator in the supergroup or channel for this to
+// work and must have the appropriate administrator rights.
+// Returns True on success.
+func (b *Bot) BanChatSenderChat(chatID types.ChatID, senderChatID int64) error {
+	params := map[string]any{
+		"chat_id":        chatID,
+		"sender_chat_id": senderChatID,
+	}
+
+	_, err := request[bool](b, banChatSenderChatUrl, params)
+	return err
+}
+
+// Use this method to unban a previously banned channel chat in a
+// supergroup or channel. The bot must be an administrator for this
+// to work and must have the appropriate administrator rights.
+// Returns True on success.
+func (b *Bot) UnbanChatSenderChat(chatID types.ChatID, senderChatID int64) error {
+	params := map[string]any{
+		"chat_id":        chatID,
+		"sender_chat_id": senderChatID,
+	}
+
+	_, err := request[bool](b, unbanChatSenderChatUrl, params)
+	return err
+}
+
+type SetChatPermissionsOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target supergroup (in the format @supergroupusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// A JSON-serialized object for new default chat permissions
+	Permissions types.ChatPermissions `json:"permissions"`
+
+	// Pass True if chat permissions are set independently. Otherwise,
+	// the can_send_other_messages and can_add_web_page_previews
+	// permissions will imply the can_send_messages, can_send_audios,
+	// can_send_documents, can_send_photos, can_send_videos,
+	// can_send_video_notes, and can_send_voice_notes permissions;
+	// the can_send_polls permission will imply the can_send_messages
+	// permission.
+	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
+}
+
+// Use this method to set default chat permissions for all members.
+// The bot must be an administrator in the group or a supergroup for
+// this to work and must have the can_restrict_members administrator
+// rights. Returns True on success.
+func (b *Bot) SetChatPermissions(options SetChatPermissionsOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, setChatPermissionsUrl, options)
+	return err
+}
+
+// Mute forbids the user to send anything to the supergroup for the
+// given duration. A zero duration, or one shorter than 30 seconds or
+// longer than 366 days, mutes the user forever.
+func (b *Bot) Mute(chatID types.ChatID, userID int64, duration time.Duration) error {
+	var until time.Time
+	if duration > 0 {
+		until = time.Now().Add(duration)
+	}
+
+	return b.RestrictChatMember(RestrictChatMemberOptions{
+		ChatID:                        chatID,
+		UserID:                        userID,
+		UseIndependentChatPermissions: true,
+		UntilDate:                     until,
+	})
+}
+
+// Unmute lifts all restrictions from the user, so the default
+// permissions of the chat apply again.
+func (b *Bot) Unmute(chatID types.ChatID, userID int64) error {
+	return b.RestrictChatMember(RestrictChatMemberOptions{
+		ChatID:      chatID,
+		UserID:      userID,
+		Permissions: types.AllChatPermissions(),
+	})
+}
+
+// Kick removes the user from the chat without banning them,
+// so they are able to join it again.
+func (b *Bot) Kick(chatID types.ChatID, userID int64) error {
+	err := b.BanChatMember(BanChatMemberOptions{
+		ChatID: chatID,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return b.UnbanChatMember(UnbanChatMemberOptions{
+		ChatID:       chatID,
+		UserID:       userID,
+		OnlyIfBanned: true,
+	})
+}
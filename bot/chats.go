@@ -0,0 +1,237 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const (
+	getChatUrl              = "/getChat"
+	setChatTitleUrl         = "/setChatTitle"
+	setChatDescriptionUrl   = "/setChatDescription"
+	setChatPhotoUrl         = "/setChatPhoto"
+	deleteChatPhotoUrl      = "/deleteChatPhoto"
+	pinChatMessageUrl       = "/pinChatMessage"
+	unpinChatMessageUrl     = "/unpinChatMessage"
+	unpinAllChatMessagesUrl = "/unpinAllChatMessages"
+	leaveChatUrl            = "/leaveChat"
+	getChatMemberCountUrl   = "/getChatMemberCount"
+	setChatStickerSetUrl    = "/setChatStickerSet"
+	deleteChatStickerSetUrl = "/deleteChatStickerSet"
+
+	maxChatTitleLength       = 128
+	maxChatDescriptionLength = 255
+)
+
+// Use this method to get up-to-date information about the chat.
+// Returns a ChatFullInfo object on success.
+func (b *Bot) GetChat(chatID types.ChatID) (types.ChatFullInfo, error) {
+	params := map[string]any{"chat_id": chatID}
+
+	return request[types.ChatFullInfo](b, getChatUrl, params)
+}
+
+// Use this method to change the title of a chat. Titles can't be
+// changed for private chats. The bot must be an administrator in
+// the chat for this to work and must have the appropriate
+// administrator rights. The title is 1-128 characters.
+// Returns True on success.
+func (b *Bot) SetChatTitle(chatID types.ChatID, title string) error {
+	if title == "" {
+		return errors.New("title is required")
+	}
+
+	if err := checkLength("title", title, maxChatTitleLength); err != nil {
+		return err
+	}
+
+	params := map[string]any{
+		"chat_id": chatID,
+		"title":   title,
+	}
+
+	_, err := request[bool](b, setChatTitleUrl, params)
+	return err
+}
+
+// Use this method to change the description of a group, a supergroup
+// or a channel. The bot must be an administrator in the chat for this
+// to work and must have the appropriate administrator rights. The
+// description is 0-255 characters. Returns True on success.
+func (b *Bot) SetChatDescription(chatID types.ChatID, description string) error {
+	err := checkLength("description", description, maxChatDescriptionLength)
+	if err != nil {
+		return err
+	}
+
+	params := map[string]any{
+		"chat_id":     chatID,
+		"description": description,
+	}
+
+	_, err = request[bool](b, setChatDescriptionUrl, params)
+	return err
+}
+
+// Use this method to set a new profile photo for the chat, uploaded
+// from the file at photoPath. Photos can't be changed for private
+// chats. The bot must be an administrator in the chat for this to
+// work and must have the appropriate administrator rights.
+// Returns True on success.
+func (b *Bot) SetChatPhoto(chatID types.ChatID, photoPath string) error {
+	f, err := os.Open(photoPath)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	return b.SetChatPhotoFromReader(chatID, filepath.Base(photoPath), f)
+}
+
+// SetChatPhotoFromReader works like SetChatPhoto,
+// but reads the photo named name from photo.
+func (b *Bot) SetChatPhotoFromReader(chatID types.ChatID, name string, photo io.Reader) error {
+	fields := map[string]string{"chat_id": chatID.String()}
+	file := uploadFile{field: "photo", name: name, reader: photo}
+
+	_, err := requestWithFile[bool](b, setChatPhotoUrl, fields, file)
+	return err
+}
+
+// Use this method to delete a chat photo. Photos can't be changed
+// for private chats. The bot must be an administrator in the chat
+// for this to work and must have the appropriate administrator
+// rights. Returns True on success.
+func (b *Bot) DeleteChatPhoto(chatID types.ChatID) error {
+	params := map[string]any{"chat_id": chatID}
+
+	_, err := request[bool](b, deleteChatPhotoUrl, params)
+	return err
+}
+
+type PinChatMessageOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message will be pinned
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Identifier of a message to pin
+	MessageID int `json:"message_id" validate:"required"`
+
+	// Pass True if it is not necessary to send a notification to
+	// all chat members about the new pinned message. Notifications
+	// are always disabled in channels and private chats.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+}
+
+// Use this method to add a message to the list of pinned messages
+// in a chat. In private chats and channel direct messages chats, all
+// non-service messages can be pinned. Conversely, the bot must be an
+// administrator with the 'can_pin_messages' right or the
+// 'can_edit_messages' right to pin messages in groups and channels
+// respectively. Returns True on success.
+func (b *Bot) PinChatMessage(options PinChatMessageOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, pinChatMessageUrl, options)
+	return err
+}
+
+type UnpinChatMessageOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message will be unpinned
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Identifier of the message to unpin. Required if
+	// business_connection_id is specified. If not specified,
+	// the most recent pinned message (by sending date)
+	// will be unpinned.
+	MessageID int `json:"message_id,omitempty"`
+}
+
+// Use this method to remove a message from the list of pinned
+// messages in a chat. In private chats and channel direct messages
+// chats, all messages can be unpinned. Conversely, the bot must be
+// an administrator with the 'can_pin_messages' right or the
+// 'can_edit_messages' right to unpin messages in groups and channels
+// respectively. Returns True on success.
+func (b *Bot) UnpinChatMessage(options UnpinChatMessageOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, unpinChatMessageUrl, options)
+	return err
+}
+
+// Use this method to clear the list of pinned messages in a chat.
+// In private chats and channel direct messages chats, no additional
+// rights are required to unpin all pinned messages. Conversely, the
+// bot must be an administrator with the 'can_pin_messages' right or
+// the 'can_edit_messages' right to unpin all pinned messages in
+// groups and channels respectively. Returns True on success.
+func (b *Bot) UnpinAllChatMessages(chatID types.ChatID) error {
+	params := map[string]any{"chat_id": chatID}
+
+	_, err := request[bool](b, unpinAllChatMessagesUrl, params)
+	return err
+}
+
+// Use this method for your bot to leave a group, supergroup or
+// channel. Returns True on success.
+func (b *Bot) LeaveChat(chatID types.ChatID) error {
+	params := map[string]any{"chat_id": chatID}
+
+	_, err := request[bool](b, leaveChatUrl, params)
+	return err
+}
+
+// Use this method to get the number of members in a chat.
+// Returns Int on success.
+func (b *Bot) GetChatMemberCount(chatID types.ChatID) (int, error) {
+	params := map[string]any{"chat_id": chatID}
+
+	return request[int](b, getChatMemberCountUrl, params)
+}
+
+// Use this method to set a new group sticker set for a supergroup.
+// The bot must be an administrator in the chat for this to work and
+// must have the appropriate administrator rights. Use the field
+// can_set_sticker_set optionally returned in getChat requests to
+// check if the bot can use this method. Returns True on success.
+func (b *Bot) SetChatStickerSet(chatID types.ChatID, stickerSetName string) error {
+	params := map[string]any{
+		"chat_id":          chatID,
+		"sticker_set_name": stickerSetName,
+	}
+
+	_, err := request[bool](b, setChatStickerSetUrl, params)
+	return err
+}
+
+// Use this method to delete a group sticker set from a supergroup.
+// The bot must be an administrator in the chat for this to work and
+// must have the appropriate administrator rights. Use the field
+// can_set_sticker_set optionally returned in getChat requests to
+// check if the bot can use this method. Returns True on success.
+func (b *Bot) DeleteChatStickerSet(chatID types.ChatID) error {
+	params := map[string]any{"chat_id": chatID}
+
+	_, err := request[bool](b, deleteChatStickerSetUrl, params)
+	return err
+}
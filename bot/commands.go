@@ -0,0 +1,73 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+const (
+	setMyCommandsUrl    = "/setMyCommands"
+	getMyCommandsUrl    = "/getMyCommands"
+	deleteMyCommandsUrl = "/deleteMyCommands"
+
+	maxCommands = 100
+)
+
+type SetMyCommandsOptions struct {
+	// A JSON-serialized list of bot commands to be set as the list of
+	// the bot's commands. At most 100 commands can be specified.
+	Commands []types.BotCommand `json:"commands"`
+
+	// A JSON-serialized object, describing scope of users
+	// for which the commands are relevant. Defaults to
+	// BotCommandScopeDefault.
+	Scope *types.BotCommandScope `json:"scope,omitempty"`
+
+	// A two-letter ISO 639-1 language code. If empty, commands
+	// will be applied to all users from the given scope, for
+	// whose language there are no dedicated commands
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Use this method to change the list of the bot's commands.
+// See this manual for more details about bot commands.
+// (https://core.telegram.org/bots/features#commands)
+// Returns True on success.
+func (b *Bot) SetMyCommands(options SetMyCommandsOptions) error {
+	if len(options.Commands) == 0 {
+		return errors.New("at least one command is required, use DeleteMyCommands to remove them")
+	}
+
+	if len(options.Commands) > maxCommands {
+		return fmt.Errorf("too many commands: %d (max: %d)", len(options.Commands), maxCommands)
+	}
+
+	_, err := request[bool](b, setMyCommandsUrl, options)
+	return err
+}
+
+type MyCommandsOptions struct {
+	// A JSON-serialized object, describing scope
+	// of users. Defaults to BotCommandScopeDefault.
+	Scope *types.BotCommandScope `json:"scope,omitempty"`
+
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Use this method to get the current list of the bot's commands for
+// the given scope and user language. Returns an Array of BotCommand
+// objects. If commands aren't set, an empty list is returned.
+func (b *Bot) GetMyCommands(options MyCommandsOptions) ([]types.BotCommand, error) {
+	return request[[]types.BotCommand](b, getMyCommandsUrl, options)
+}
+
+// Use this method to delete the list of the bot's commands for the
+// given scope and user language. After deletion, higher level
+// commands will be shown to affected users. Returns True on success.
+func (b *Bot) DeleteMyCommands(options MyCommandsOptions) error {
+	_, err := request[bool](b, deleteMyCommandsUrl, options)
+	return err
+}
@@ -0,0 +1,128 @@
+package bot
+
+import (
+	"errors"
+
+	"github.com/purkhanov/gogram/api"
+	"github.com/purkhanov/gogram/types"
+)
+
+// EditOrSendAction tells which path EditOrSend has taken.
+type EditOrSendAction int
+
+const (
+	// The message has been edited
+	ActionEdited EditOrSendAction = iota + 1
+
+	// The message already had the requested content
+	ActionNotModified
+
+	// The message could not be edited, so a new one has been sent
+	ActionSent
+)
+
+func (a EditOrSendAction) String() string {
+	switch a {
+	case ActionEdited:
+		return "edited"
+	case ActionNotModified:
+		return "not modified"
+	case ActionSent:
+		return "sent"
+	default:
+		return "unknown"
+	}
+}
+
+type EditOrSendOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message is edited or sent
+	BusinessConnectionID string
+
+	// The message to edit. A new message is sent to the chat of
+	// the target if the message can't be edited. Inline messages
+	// have no chat, so there is no fallback for them.
+	Target MessageTarget
+
+	// Text of the message, 1-4096 characters after entities parsing
+	Text string
+
+	// Mode for parsing entities in the message text.
+	// See formatting options for more details.
+	ParseMode types.ParseMode
+
+	// A list of special entities that appear in message
+	// text, which can be specified instead of parse_mode
+	Entities []types.MessageEntity
+
+	// Link preview generation options for the message
+	LinkPreviewOptions *types.LinkPreviewOptions
+
+	// An inline keyboard attached to the message
+	ReplyMarkup *types.InlineKeyboardMarkup
+}
+
+type EditOrSendResult struct {
+	// The path that has been taken
+	Action EditOrSendAction
+
+	// The edited or sent message. Nil if the message
+	// was not modified or an inline message was edited.
+	Message *types.Message
+}
+
+// EditOrSend edits the text of the target message. If the message
+// already has the requested content, it succeeds with ActionNotModified.
+// If the message was deleted or can't be edited anymore, e.g. a business
+// message older than 48 hours, a new message is sent to the same chat.
+func (b *Bot) EditOrSend(options EditOrSendOptions) (EditOrSendResult, error) {
+	if err := options.Target.validate(); err != nil {
+		return EditOrSendResult{}, err
+	}
+
+	if options.Text == "" {
+		return EditOrSendResult{}, errors.New("text is required")
+	}
+
+	edited, err := b.EditMessageText(EditMessageTextOptions{
+		BusinessConnectionID: options.BusinessConnectionID,
+		MessageTarget:        options.Target,
+		Text:                 options.Text,
+		ParseMode:            options.ParseMode,
+		Entities:             options.Entities,
+		LinkPreviewOptions:   options.LinkPreviewOptions,
+		ReplyMarkup:          options.ReplyMarkup,
+	})
+
+	switch {
+	case err == nil:
+		return EditOrSendResult{Action: ActionEdited, Message: edited.Message}, nil
+
+	case api.IsMessageNotModified(err):
+		return EditOrSendResult{Action: ActionNotModified}, nil
+
+	case !api.IsMessageNotEditable(err) || options.Target.IsInline():
+		return EditOrSendResult{}, err
+	}
+
+	params := SendMessageOptions{
+		BusinessConnectionID: options.BusinessConnectionID,
+		ChatID:               options.Target.ChatID,
+		Text:                 options.Text,
+		ParseMode:            options.ParseMode,
+		Entities:             options.Entities,
+		LinkPreviewOptions:   options.LinkPreviewOptions,
+	}
+
+	// A nil pointer stored in the interface would be sent as null
+	if options.ReplyMarkup != nil {
+		params.ReplyMarkup = options.ReplyMarkup
+	}
+
+	sent, err := b.SendMessage(params)
+	if err != nil {
+		return EditOrSendResult{}, err
+	}
+
+	return EditOrSendResult{Action: ActionSent, Message: &sent}, nil
+}
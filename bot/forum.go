@@ -0,0 +1,229 @@
+package bot
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const (
+	getForumTopicIconStickersUrl         = "/getForumTopicIconStickers"
+	createForumTopicUrl                  = "/createForumTopic"
+	editForumTopicUrl                    = "/editForumTopic"
+	closeForumTopicUrl                   = "/closeForumTopic"
+	reopenForumTopicUrl                  = "/reopenForumTopic"
+	deleteForumTopicUrl                  = "/deleteForumTopic"
+	unpinAllForumTopicMessagesUrl        = "/unpinAllForumTopicMessages"
+	editGeneralForumTopicUrl             = "/editGeneralForumTopic"
+	closeGeneralForumTopicUrl            = "/closeGeneralForumTopic"
+	reopenGeneralForumTopicUrl           = "/reopenGeneralForumTopic"
+	hideGeneralForumTopicUrl             = "/hideGeneralForumTopic"
+	unhideGeneralForumTopicUrl           = "/unhideGeneralForumTopic"
+	unpinAllGeneralForumTopicMessagesUrl = "/unpinAllGeneralForumTopicMessages"
+
+	maxTopicNameLength = 128
+)
+
+var forumTopicIconColors = []int{
+	types.ForumTopicIconColorBlue,
+	types.ForumTopicIconColorYellow,
+	types.ForumTopicIconColorViolet,
+	types.ForumTopicIconColorGreen,
+	types.ForumTopicIconColorRose,
+	types.ForumTopicIconColorRed,
+}
+
+// Use this method to get custom emoji stickers, which can be used
+// as a forum topic icon by any user. Requires no parameters.
+// Returns an Array of Sticker objects.
+func (b *Bot) GetForumTopicIconStickers() ([]types.Sticker, error) {
+	return request[[]types.Sticker](b, getForumTopicIconStickersUrl, struct{}{})
+}
+
+type CreateForumTopicOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target supergroup (in the format @supergroupusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Topic name, 1-128 characters
+	Name string `json:"name" validate:"required"`
+
+	// Color of the topic icon in RGB format. Currently, must be
+	// one of the ForumTopicIconColor constants
+	IconColor int `json:"icon_color,omitempty"`
+
+	// Unique identifier of the custom emoji shown as the topic icon.
+	// Use getForumTopicIconStickers to get all allowed custom emoji
+	// identifiers.
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// Use this method to create a topic in a forum supergroup chat. The
+// bot must be an administrator in the chat for this to work and must
+// have the can_manage_topics administrator rights. Returns information
+// about the created topic as a ForumTopic object.
+func (b *Bot) CreateForumTopic(options CreateForumTopicOptions) (types.ForumTopic, error) {
+	if err := utils.ValidateStruct(options); err != nil {
+		return types.ForumTopic{}, err
+	}
+
+	if err := checkLength("name", options.Name, maxTopicNameLength); err != nil {
+		return types.ForumTopic{}, err
+	}
+
+	if options.IconColor != 0 && !slices.Contains(forumTopicIconColors, options.IconColor) {
+		return types.ForumTopic{}, errors.New("unsupported topic icon color")
+	}
+
+	return request[types.ForumTopic](b, createForumTopicUrl, options)
+}
+
+type EditForumTopicOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target supergroup (in the format @supergroupusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadID int `json:"message_thread_id" validate:"required"`
+
+	// New topic name, 0-128 characters. If not specified or
+	// empty, the current name of the topic will be kept
+	Name string `json:"name,omitempty"`
+
+	// New unique identifier of the custom emoji shown as the topic
+	// icon. Use getForumTopicIconStickers to get all allowed custom
+	// emoji identifiers. Pass an empty string to remove the icon.
+	// If not specified, the current icon will be kept
+	IconCustomEmojiID *string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// Use this method to edit name and icon of a topic in a forum
+// supergroup chat. The bot must be an administrator in the chat for
+// this to work and must have the can_manage_topics administrator
+// rights, unless it is the creator of the topic. Returns True on success.
+func (b *Bot) EditForumTopic(options EditForumTopicOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	if err := checkLength("name", options.Name, maxTopicNameLength); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, editForumTopicUrl, options)
+	return err
+}
+
+// Use this method to close an open topic in a forum supergroup chat.
+// The bot must be an administrator in the chat for this to work and
+// must have the can_manage_topics administrator rights, unless it is
+// the creator of the topic. Returns True on success.
+func (b *Bot) CloseForumTopic(chatID types.ChatID, messageThreadID int) error {
+	return b.topicRequest(closeForumTopicUrl, chatID, messageThreadID)
+}
+
+// Use this method to reopen a closed topic in a forum supergroup chat.
+// The bot must be an administrator in the chat for this to work and
+// must have the can_manage_topics administrator rights, unless it is
+// the creator of the topic. Returns True on success.
+func (b *Bot) ReopenForumTopic(chatID types.ChatID, messageThreadID int) error {
+	return b.topicRequest(reopenForumTopicUrl, chatID, messageThreadID)
+}
+
+// Use this method to delete a forum topic along with all its messages
+// in a forum supergroup chat. The bot must be an administrator in the
+// chat for this to work and must have the can_delete_messages
+// administrator rights. Returns True on success.
+func (b *Bot) DeleteForumTopic(chatID types.ChatID, messageThreadID int) error {
+	return b.topicRequest(deleteForumTopicUrl, chatID, messageThreadID)
+}
+
+// Use this method to clear the list of pinned messages in a forum
+// topic. The bot must be an administrator in the chat for this to
+// work and must have the can_pin_messages administrator right in the
+// supergroup. Returns True on success.
+func (b *Bot) UnpinAllForumTopicMessages(chatID types.ChatID, messageThreadID int) error {
+	return b.topicRequest(unpinAllForumTopicMessagesUrl, chatID, messageThreadID)
+}
+
+func (b *Bot) topicRequest(methodUrl string, chatID types.ChatID, messageThreadID int) error {
+	params := map[string]any{
+		"chat_id":           chatID,
+		"message_thread_id": messageThreadID,
+	}
+
+	_, err := request[bool](b, methodUrl, params)
+	return err
+}
+
+// Use this method to edit the name of the 'General' topic in a forum
+// supergroup chat. The bot must be an administrator in the chat for
+// this to work and must have the can_manage_topics administrator
+// rights. The name is 1-128 characters. Returns True on success.
+func (b *Bot) EditGeneralForumTopic(chatID types.ChatID, name string) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+
+	if err := checkLength("name", name, maxTopicNameLength); err != nil {
+		return err
+	}
+
+	params := map[string]any{
+		"chat_id": chatID,
+		"name":    name,
+	}
+
+	_, err := request[bool](b, editGeneralForumTopicUrl, params)
+	return err
+}
+
+// Use this method to close an open 'General' topic in a forum
+// supergroup chat. The bot must be an administrator in the chat for
+// this to work and must have the can_manage_topics administrator
+// rights. Returns True on success.
+func (b *Bot) CloseGeneralForumTopic(chatID types.ChatID) error {
+	return b.generalTopicRequest(closeGeneralForumTopicUrl, chatID)
+}
+
+// Use this method to reopen a closed 'General' topic in a forum
+// supergroup chat. The bot must be an administrator in the chat for
+// this to work and must have the can_manage_topics administrator
+// rights. The topic will be automatically unhidden if it was hidden.
+// Returns True on success.
+func (b *Bot) ReopenGeneralForumTopic(chatID types.ChatID) error {
+	return b.generalTopicRequest(reopenGeneralForumTopicUrl, chatID)
+}
+
+// Use this method to hide the 'General' topic in a forum supergroup
+// chat. The bot must be an administrator in the chat for this to work
+// and must have the can_manage_topics administrator rights. The topic
+// will be automatically closed if it was open. Returns True on success.
+func (b *Bot) HideGeneralForumTopic(chatID types.ChatID) error {
+	return b.generalTopicRequest(hideGeneralForumTopicUrl, chatID)
+}
+
+// Use this method to unhide the 'General' topic in a forum supergroup
+// chat. The bot must be an administrator in the chat for this to work
+// and must have the can_manage_topics administrator rights.
+// Returns True on success.
+func (b *Bot) UnhideGeneralForumTopic(chatID types.ChatID) error {
+	return b.generalTopicRequest(unhideGeneralForumTopicUrl, chatID)
+}
+
+// Use this method to clear the list of pinned messages in a General
+// forum topic. The bot must be an administrator in the chat for this
+// to work and must have the can_pin_messages administrator right in
+// the supergroup. Returns True on success.
+func (b *Bot) UnpinAllGeneralForumTopicMessages(chatID types.ChatID) error {
+	return b.generalTopicRequest(unpinAllGeneralForumTopicMessagesUrl, chatID)
+}
+
+func (b *Bot) generalTopicRequest(methodUrl string, chatID types.ChatID) error {
+	params := map[string]any{"chat_id": chatID}
+
+	_, err := request[bool](b, methodUrl, params)
+	return err
+}
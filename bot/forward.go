@@ -0,0 +1,298 @@
+package bot
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const (
+	forwardMessageUrl  = "/forwardMessage"
+	forwardMessagesUrl = "/forwardMessages"
+	copyMessageUrl     = "/copyMessage"
+	copyMessagesUrl    = "/copyMessages"
+
+	// The maximum number of messages forwarded or copied at once
+	maxMessagesPerRequest = 100
+)
+
+type ForwardMessageOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread (topic)
+	// of the forum; for forum supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Identifier of the direct messages topic to which the message
+	// will be forwarded; required if the message is forwarded to a
+	// direct messages chat
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
+
+	// Unique identifier for the chat where the original message was
+	// sent (or channel username in the format @channelusername)
+	FromChatID types.ChatID `json:"from_chat_id" validate:"required"`
+
+	// New start timestamp for the forwarded video in the message
+	VideoStartTimestamp int `json:"video_start_timestamp,omitempty"`
+
+	// Sends the message silently. Users will
+	// receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Protects the contents of the forwarded message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// A JSON-serialized object containing the parameters of the
+	// suggested post to send; for direct messages chats only
+	SuggestedPostParameters *types.SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+
+	// Message identifier in the chat specified in from_chat_id
+	MessageID int `json:"message_id" validate:"required"`
+}
+
+// Use this method to forward messages of any kind. Service messages
+// and messages with protected content can't be forwarded. On success,
+// the sent Message is returned.
+func (b *Bot) ForwardMessage(params ForwardMessageOptions) (types.Message, error) {
+	if err := utils.ValidateStruct(params); err != nil {
+		return types.Message{}, err
+	}
+
+	return request[types.Message](b, forwardMessageUrl, params)
+}
+
+type ForwardMessagesOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread (topic)
+	// of the forum; for forum supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Identifier of the direct messages topic to which the messages
+	// will be forwarded; required if the messages are forwarded to a
+	// direct messages chat
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
+
+	// Unique identifier for the chat where the original messages were
+	// sent (or channel username in the format @channelusername)
+	FromChatID types.ChatID `json:"from_chat_id" validate:"required"`
+
+	// A JSON-serialized list of 1-100 identifiers of messages in the
+	// chat from_chat_id to forward. The identifiers must be specified
+	// in a strictly increasing order.
+	MessageIDs []int `json:"message_ids" validate:"required"`
+
+	// Sends the messages silently. Users will
+	// receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Protects the contents of the forwarded messages from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+}
+
+// Use this method to forward multiple messages of any kind. If some
+// of the specified messages can't be found or forwarded, they are
+// skipped. Service messages and messages with protected content can't
+// be forwarded. Album grouping is kept for forwarded messages. On
+// success, an array of MessageId of the sent messages is returned.
+func (b *Bot) ForwardMessages(params ForwardMessagesOptions) ([]types.MessageId, error) {
+	if err := utils.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+
+	if len(params.MessageIDs) > maxMessagesPerRequest {
+		return nil, fmt.Errorf(
+			"at most %d messages can be forwarded at once, use ForwardMessagesInBatches",
+			maxMessagesPerRequest,
+		)
+	}
+
+	return request[[]types.MessageId](b, forwardMessagesUrl, params)
+}
+
+// ForwardMessagesInBatches forwards any number of messages, sorting
+// the identifiers and sending them in batches of 100.
+func (b *Bot) ForwardMessagesInBatches(params ForwardMessagesOptions) ([]types.MessageId, error) {
+	return inBatches(params.MessageIDs, func(ids []int) ([]types.MessageId, error) {
+		params.MessageIDs = ids
+		return b.ForwardMessages(params)
+	})
+}
+
+type CopyMessageOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread (topic)
+	// of the forum; for forum supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Identifier of the direct messages topic to which the message
+	// will be sent; required if the message is sent to a direct
+	// messages chat
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
+
+	// Unique identifier for the chat where the original message was
+	// sent (or channel username in the format @channelusername)
+	FromChatID types.ChatID `json:"from_chat_id" validate:"required"`
+
+	// Message identifier in the chat specified in from_chat_id
+	MessageID int `json:"message_id" validate:"required"`
+
+	// New start timestamp for the copied video in the message
+	VideoStartTimestamp int `json:"video_start_timestamp,omitempty"`
+
+	// New caption for media, 0-1024 characters after entities
+	// parsing. If not specified, the original caption is kept
+	Caption *string `json:"caption,omitempty"`
+
+	// Mode for parsing entities in the new caption.
+	// See formatting options for more details.
+	ParseMode types.ParseMode `json:"parse_mode,omitempty"`
+
+	// A JSON-serialized list of special entities that appear in the
+	// new caption, which can be specified instead of parse_mode
+	CaptionEntities []types.MessageEntity `json:"caption_entities,omitempty"`
+
+	// Pass True, if the caption must be shown above the message
+	// media. Ignored if a new caption isn't specified.
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Sends the message silently. Users will
+	// receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Pass True to allow up to 1000 messages per second, ignoring
+	// broadcasting limits for a fee of 0.1 Telegram Stars per message.
+	// The relevant Stars will be withdrawn from the bot's balance
+	AllowPaidBroadcast bool `json:"allow_paid_broadcast,omitempty"`
+
+	// A JSON-serialized object containing the parameters of the
+	// suggested post to send; for direct messages chats only.
+	// If the message is sent as a reply to another suggested post,
+	// then that suggested post is automatically declined.
+	SuggestedPostParameters *types.SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+
+	// Description of the message to reply to
+	ReplyParameters *types.ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// Additional interface options. A JSON-serialized object for
+	// an inline keyboard, custom reply keyboard, instructions to
+	// remove a reply keyboard or to force a reply from the user
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// Use this method to copy messages of any kind. Service messages,
+// paid media messages, giveaway messages, giveaway winners messages,
+// and invoice messages can't be copied. A quiz poll can be copied
+// only if the value of the field correct_option_id is known to the
+// bot. The method is analogous to the method forwardMessage, but the
+// copied message doesn't have a link to the original message. Returns
+// the MessageId of the sent message on success.
+func (b *Bot) CopyMessage(params CopyMessageOptions) (types.MessageId, error) {
+	if err := utils.ValidateStruct(params); err != nil {
+		return types.MessageId{}, err
+	}
+
+	return request[types.MessageId](b, copyMessageUrl, params)
+}
+
+type CopyMessagesOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread (topic)
+	// of the forum; for forum supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Identifier of the direct messages topic to which the messages
+	// will be sent; required if the messages are sent to a direct
+	// messages chat
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
+
+	// Unique identifier for the chat where the original messages were
+	// sent (or channel username in the format @channelusername)
+	FromChatID types.ChatID `json:"from_chat_id" validate:"required"`
+
+	// A JSON-serialized list of 1-100 identifiers of messages in the
+	// chat from_chat_id to copy. The identifiers must be specified
+	// in a strictly increasing order.
+	MessageIDs []int `json:"message_ids" validate:"required"`
+
+	// Sends the messages silently. Users will
+	// receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Protects the contents of the sent messages from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Pass True to copy the messages without their captions
+	RemoveCaption bool `json:"remove_caption,omitempty"`
+}
+
+// Use this method to copy messages of any kind. If some of the
+// specified messages can't be found or copied, they are skipped.
+// Service messages, paid media messages, giveaway messages, giveaway
+// winners messages, and invoice messages can't be copied. A quiz poll
+// can be copied only if the value of the field correct_option_id is
+// known to the bot. The method is analogous to the method
+// forwardMessages, but the copied messages don't have a link to the
+// original message. Album grouping is kept for copied messages. On
+// success, an array of MessageId of the sent messages is returned.
+func (b *Bot) CopyMessages(params CopyMessagesOptions) ([]types.MessageId, error) {
+	if err := utils.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+
+	if len(params.MessageIDs) > maxMessagesPerRequest {
+		return nil, fmt.Errorf(
+			"at most %d messages can be copied at once, use CopyMessagesInBatches",
+			maxMessagesPerRequest,
+		)
+	}
+
+	return request[[]types.MessageId](b, copyMessagesUrl, params)
+}
+
+// CopyMessagesInBatches copies any number of messages, sorting
+// the identifiers and sending them in batches of 100.
+func (b *Bot) CopyMessagesInBatches(params CopyMessagesOptions) ([]types.MessageId, error) {
+	return inBatches(params.MessageIDs, func(ids []int) ([]types.MessageId, error) {
+		params.MessageIDs = ids
+		return b.CopyMessages(params)
+	})
+}
+
+// inBatches calls send for sorted unique message identifiers split
+// into batches. On error, the results collected so far are returned.
+func inBatches(
+	messageIDs []int, send func([]int) ([]types.MessageId, error),
+) ([]types.MessageId, error) {
+	ids := slices.Clone(messageIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	var result []types.MessageId
+
+	for batch := range slices.Chunk(ids, maxMessagesPerRequest) {
+		sent, err := send(batch)
+		if err != nil {
+			return result, err
+		}
+
+		result = append(result, sent...)
+	}
+
+	return result, nil
+}
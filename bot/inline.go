@@ -0,0 +1,69 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const (
+	answerInlineQueryUrl = "/answerInlineQuery"
+
+	maxInlineQueryResults = 50
+)
+
+type AnswerInlineQueryOptions struct {
+	// Unique identifier for the answered query
+	InlineQueryID string `json:"inline_query_id" validate:"required"`
+
+	// A JSON-serialized array of results for the inline query.
+	// No more than 50 results per query are allowed.
+	Results []types.InlineQueryResult `json:"results"`
+
+	// The maximum amount of time in seconds that the result of the
+	// inline query may be cached on the server. Defaults to 300.
+	CacheTime int `json:"cache_time,omitempty"`
+
+	// Pass True if results may be cached on the server side only for
+	// the user that sent the query. By default, results may be returned
+	// to any user who sends the same query.
+	IsPersonal bool `json:"is_personal,omitempty"`
+
+	// Pass the offset that a client should send in the next query with
+	// the same text to receive more results. Pass an empty string if
+	// there are no more results or if you don't support pagination.
+	// Offset length can't exceed 64 bytes.
+	NextOffset string `json:"next_offset,omitempty"`
+
+	// A JSON-serialized object describing a button to be shown
+	// above inline query results
+	Button *types.InlineQueryResultsButton `json:"button,omitempty"`
+}
+
+// Use this method to send answers to an inline query.
+// No more than 50 results per query are allowed.
+func (b *Bot) AnswerInlineQuery(options AnswerInlineQueryOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	if len(options.Results) > maxInlineQueryResults {
+		return fmt.Errorf(
+			"too many inline query results: %d (max: %d)",
+			len(options.Results), maxInlineQueryResults,
+		)
+	}
+
+	if len(options.NextOffset) > 64 {
+		return errors.New("next offset can't exceed 64 bytes")
+	}
+
+	if options.Results == nil {
+		options.Results = []types.InlineQueryResult{}
+	}
+
+	_, err := request[bool](b, answerInlineQueryUrl, options)
+	return err
+}
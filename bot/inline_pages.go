@@ -0,0 +1,88 @@
+package bot
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+// InlinePageSource returns the results of the query starting at
+// offset, at most limit of them. Returning fewer results than
+// limit tells that there are no more pages.
+type InlinePageSource func(query *types.InlineQuery, offset, limit int) ([]types.InlineQueryResult, error)
+
+type InlinePageOptions struct {
+	// Number of results on a page, 1-50. Defaults to 50.
+	PageSize int
+
+	// The maximum amount of time in seconds that the result of the
+	// inline query may be cached on the server. Defaults to 300.
+	CacheTime int
+
+	// Pass True if results may be cached on the server side only
+	// for the user that sent the query
+	IsPersonal bool
+
+	// Optional. A button to be shown above the results,
+	// sent with the first page only
+	Button *types.InlineQueryResultsButton
+}
+
+// AnswerInlineQueryPage answers the query with the page of results
+// its offset points to, and sets the offset of the next page when
+// there may be more results. The offset is the number of results
+// already sent, so an empty offset is the first page.
+//
+// The source is asked for one result more than the page size
+// to learn whether the next page exists.
+func (b *Bot) AnswerInlineQueryPage(
+	query *types.InlineQuery, source InlinePageSource, options InlinePageOptions,
+) error {
+	pageSize := options.PageSize
+	if pageSize <= 0 || pageSize > maxInlineQueryResults {
+		pageSize = maxInlineQueryResults
+	}
+
+	offset, err := parseInlineOffset(query.Offset)
+	if err != nil {
+		return err
+	}
+
+	results, err := source(query, offset, pageSize+1)
+	if err != nil {
+		return err
+	}
+
+	answer := AnswerInlineQueryOptions{
+		InlineQueryID: query.ID,
+		Results:       results,
+		CacheTime:     options.CacheTime,
+		IsPersonal:    options.IsPersonal,
+	}
+
+	if len(results) > pageSize {
+		answer.Results = results[:pageSize]
+		answer.NextOffset = strconv.Itoa(offset + pageSize)
+	}
+
+	if offset == 0 {
+		answer.Button = options.Button
+	}
+
+	return b.AnswerInlineQuery(answer)
+}
+
+// parseInlineOffset reads the offset set by AnswerInlineQueryPage.
+func parseInlineOffset(offset string) (int, error) {
+	if offset == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(offset)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid inline query offset: %q", offset)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,254 @@
+package bot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const (
+	exportChatInviteLinkUrl             = "/exportChatInviteLink"
+	createChatInviteLinkUrl             = "/createChatInviteLink"
+	editChatInviteLinkUrl               = "/editChatInviteLink"
+	createChatSubscriptionInviteLinkUrl = "/createChatSubscriptionInviteLink"
+	editChatSubscriptionInviteLinkUrl   = "/editChatSubscriptionInviteLink"
+	revokeChatInviteLinkUrl             = "/revokeChatInviteLink"
+	approveChatJoinRequestUrl           = "/approveChatJoinRequest"
+	declineChatJoinRequestUrl           = "/declineChatJoinRequest"
+
+	maxInviteLinkNameLength  = 32
+	maxInviteLinkMemberLimit = 99999
+
+	// The only subscription period currently supported by Telegram
+	SubscriptionPeriod = 30 * 24 * time.Hour
+
+	maxSubscriptionPrice = 10000
+)
+
+// Use this method to generate a new primary invite link for a chat;
+// any previously generated primary link is revoked. The bot must be
+// an administrator in the chat for this to work and must have the
+// appropriate administrator rights. Returns the new invite link as
+// String on success.
+//
+// Note: Each administrator in a chat generates their own invite links.
+// Bots can't use invite links generated by other administrators. If
+// you want your bot to work with invite links, it will need to generate
+// its own link using exportChatInviteLink or by calling the getChat
+// method. If your bot needs to generate a new primary invite link
+// replacing its previous one, use exportChatInviteLink again.
+func (b *Bot) ExportChatInviteLink(chatID types.ChatID) (string, error) {
+	params := map[string]any{"chat_id": chatID}
+
+	return request[string](b, exportChatInviteLinkUrl, params)
+}
+
+type ChatInviteLinkOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Invite link name; 0-32 characters
+	Name string `json:"name,omitempty"`
+
+	// Point in time when the link will expire.
+	// Zero time creates a link that never expires.
+	ExpireDate time.Time `json:"-"`
+
+	// The maximum number of users that can be members of
+	// the chat simultaneously after joining the chat via
+	// this invite link; 1-99999
+	MemberLimit int `json:"member_limit,omitempty"`
+
+	// True, if users joining the chat via the link need to be
+	// approved by chat administrators. If True, member_limit
+	// can't be specified
+	CreatesJoinRequest bool `json:"creates_join_request,omitempty"`
+}
+
+func (o ChatInviteLinkOptions) MarshalJSON() ([]byte, error) {
+	type options ChatInviteLinkOptions
+
+	return json.Marshal(struct {
+		options
+		ExpireDate int64 `json:"expire_date,omitempty"`
+	}{options(o), unixDate(o.ExpireDate)})
+}
+
+func (o ChatInviteLinkOptions) validate() error {
+	if err := utils.ValidateStruct(o); err != nil {
+		return err
+	}
+
+	if err := checkLength("name", o.Name, maxInviteLinkNameLength); err != nil {
+		return err
+	}
+
+	if o.MemberLimit < 0 || o.MemberLimit > maxInviteLinkMemberLimit {
+		return fmt.Errorf("member limit must be between 1 and %d", maxInviteLinkMemberLimit)
+	}
+
+	if o.CreatesJoinRequest && o.MemberLimit != 0 {
+		return errors.New("member limit can't be specified for links that create join requests")
+	}
+
+	return nil
+}
+
+// Use this method to create an additional invite link for a chat.
+// The bot must be an administrator in the chat for this to work and
+// must have the appropriate administrator rights. The link can be
+// revoked using the method revokeChatInviteLink. Returns the new
+// invite link as ChatInviteLink object.
+func (b *Bot) CreateChatInviteLink(options ChatInviteLinkOptions) (types.ChatInviteLink, error) {
+	if err := options.validate(); err != nil {
+		return types.ChatInviteLink{}, err
+	}
+
+	return request[types.ChatInviteLink](b, createChatInviteLinkUrl, options)
+}
+
+type EditChatInviteLinkOptions struct {
+	// The invite link to edit
+	InviteLink string `json:"invite_link" validate:"required"`
+
+	// New properties of the link
+	ChatInviteLinkOptions
+}
+
+func (o EditChatInviteLinkOptions) MarshalJSON() ([]byte, error) {
+	type options ChatInviteLinkOptions
+
+	return json.Marshal(struct {
+		InviteLink string `json:"invite_link"`
+		options
+		ExpireDate int64 `json:"expire_date,omitempty"`
+	}{o.InviteLink, options(o.ChatInviteLinkOptions), unixDate(o.ExpireDate)})
+}
+
+// Use this method to edit a non-primary invite link created by the
+// bot. The bot must be an administrator in the chat for this to work
+// and must have the appropriate administrator rights. Returns the
+// edited invite link as a ChatInviteLink object.
+func (b *Bot) EditChatInviteLink(options EditChatInviteLinkOptions) (types.ChatInviteLink, error) {
+	if err := utils.ValidateStruct(options); err != nil {
+		return types.ChatInviteLink{}, err
+	}
+
+	if err := options.ChatInviteLinkOptions.validate(); err != nil {
+		return types.ChatInviteLink{}, err
+	}
+
+	return request[types.ChatInviteLink](b, editChatInviteLinkUrl, options)
+}
+
+type ChatSubscriptionInviteLinkOptions struct {
+	// Unique identifier for the target channel chat or username
+	// of the target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Invite link name; 0-32 characters
+	Name string `json:"name,omitempty"`
+
+	// The amount of Telegram Stars a user must pay initially and
+	// after each subsequent subscription period to be a member of
+	// the chat; 1-10000
+	SubscriptionPrice int `json:"subscription_price" validate:"required"`
+}
+
+// Use this method to create a subscription invite link for a channel
+// chat. The bot must have the can_invite_users administrator rights.
+// The link is active for SubscriptionPeriod before the next payment.
+// The link can be edited using the method editChatSubscriptionInviteLink
+// or revoked using the method revokeChatInviteLink. Returns the new
+// invite link as a ChatInviteLink object.
+func (b *Bot) CreateChatSubscriptionInviteLink(
+	options ChatSubscriptionInviteLinkOptions,
+) (types.ChatInviteLink, error) {
+	if err := utils.ValidateStruct(options); err != nil {
+		return types.ChatInviteLink{}, err
+	}
+
+	if err := checkLength("name", options.Name, maxInviteLinkNameLength); err != nil {
+		return types.ChatInviteLink{}, err
+	}
+
+	if options.SubscriptionPrice < 1 || options.SubscriptionPrice > maxSubscriptionPrice {
+		return types.ChatInviteLink{}, fmt.Errorf(
+			"subscription price must be between 1 and %d", maxSubscriptionPrice,
+		)
+	}
+
+	params := struct {
+		ChatSubscriptionInviteLinkOptions
+		SubscriptionPeriod int `json:"subscription_period"`
+	}{options, int(SubscriptionPeriod.Seconds())}
+
+	return request[types.ChatInviteLink](b, createChatSubscriptionInviteLinkUrl, params)
+}
+
+// Use this method to edit the name of a subscription invite link
+// created by the bot. The bot must have the can_invite_users
+// administrator rights. Returns the edited invite link as a
+// ChatInviteLink object.
+func (b *Bot) EditChatSubscriptionInviteLink(
+	chatID types.ChatID, inviteLink, name string,
+) (types.ChatInviteLink, error) {
+	if err := checkLength("name", name, maxInviteLinkNameLength); err != nil {
+		return types.ChatInviteLink{}, err
+	}
+
+	params := map[string]any{
+		"chat_id":     chatID,
+		"invite_link": inviteLink,
+		"name":        name,
+	}
+
+	return request[types.ChatInviteLink](b, editChatSubscriptionInviteLinkUrl, params)
+}
+
+// Use this method to revoke an invite link created by the bot. If the
+// primary link is revoked, a new link is automatically generated. The
+// bot must be an administrator in the chat for this to work and must
+// have the appropriate administrator rights. Returns the revoked
+// invite link as ChatInviteLink object.
+func (b *Bot) RevokeChatInviteLink(
+	chatID types.ChatID, inviteLink string,
+) (types.ChatInviteLink, error) {
+	params := map[string]any{
+		"chat_id":     chatID,
+		"invite_link": inviteLink,
+	}
+
+	return request[types.ChatInviteLink](b, revokeChatInviteLinkUrl, params)
+}
+
+// Use this method to approve a chat join request. The bot must be an
+// administrator in the chat for this to work and must have the
+// can_invite_users administrator right. Returns True on success.
+func (b *Bot) ApproveChatJoinRequest(chatID types.ChatID, userID int64) error {
+	params := map[string]any{
+		"chat_id": chatID,
+		"user_id": userID,
+	}
+
+	_, err := request[bool](b, approveChatJoinRequestUrl, params)
+	return err
+}
+
+// Use this method to decline a chat join request. The bot must be an
+// administrator in the chat for this to work and must have the
+// can_invite_users administrator right. Returns True on success.
+func (b *Bot) DeclineChatJoinRequest(chatID types.ChatID, userID int64) error {
+	params := map[string]any{
+		"chat_id": chatID,
+		"user_id": userID,
+	}
+
+	_, err := request[bool](b, declineChatJoinRequestUrl, params)
+	return err
+}
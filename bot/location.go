@@ -0,0 +1,568 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/purkhanov/gogram/api"
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const (
+	sendLocationUrl = "/sendLocation"
+	sendVenueUrl    = "/sendVenue"
+	sendContactUrl  = "/sendContact"
+	sendDiceUrl     = "/sendDice"
+
+	// LivePeriodForever is the live period of a location
+	// that can be edited indefinitely.
+	LivePeriodForever = 0x7FFFFFFF
+
+	minLivePeriod = 60
+	maxLivePeriod = 86400
+
+	maxHorizontalAccuracy   = 1500
+	maxHeading              = 360
+	maxProximityAlertRadius = 100000
+
+	maxVcardSize = 2048
+
+	defaultLiveLocationInterval = 3 * time.Second
+
+	// Consecutive failed edits after which a live location is stopped
+	maxLiveLocationFailures = 5
+)
+
+type SendLocationOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread (topic)
+	// of the forum; for forum supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Identifier of the direct messages topic to which
+	// the message will be sent; required if the message
+	// is sent to a direct messages chat
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
+
+	// Latitude of the location
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the location
+	Longitude float64 `json:"longitude"`
+
+	// The radius of uncertainty for the location,
+	// measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+
+	// Period in seconds during which the location will be updated,
+	// should be between 60 and 86400, or LivePeriodForever for live
+	// locations that can be edited indefinitely.
+	LivePeriod int `json:"live_period,omitempty"`
+
+	// For live locations, a direction in which the user is moving,
+	// in degrees. Must be between 1 and 360 if specified.
+	Heading int `json:"heading,omitempty"`
+
+	// For live locations, a maximum distance for proximity alerts
+	// about approaching another chat member, in meters.
+	// Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+
+	// Sends the message silently. Users will
+	// receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Pass True to allow up to 1000 messages per second, ignoring
+	// broadcasting limits for a fee of 0.1 Telegram Stars per message.
+	// The relevant Stars will be withdrawn from the bot's balance
+	AllowPaidBroadcast bool `json:"allow_paid_broadcast,omitempty"`
+
+	// Unique identifier of the message effect to be added
+	// to the message; for private chats only
+	MessageEffectID string `json:"message_effect_id,omitempty"`
+
+	// A JSON-serialized object containing the parameters of the
+	// suggested post to send; for direct messages chats only.
+	SuggestedPostParameters *types.SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+
+	// Description of the message to reply to
+	ReplyParameters *types.ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// Additional interface options. A JSON-serialized object for
+	// an inline keyboard, custom reply keyboard, instructions to
+	// remove a reply keyboard or to force a reply from the user
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+func (o SendLocationOptions) validate() error {
+	if err := utils.ValidateStruct(o); err != nil {
+		return err
+	}
+
+	if o.LivePeriod != 0 && o.LivePeriod != LivePeriodForever &&
+		(o.LivePeriod < minLivePeriod || o.LivePeriod > maxLivePeriod) {
+		return fmt.Errorf(
+			"live period must be between %d and %d seconds",
+			minLivePeriod, maxLivePeriod,
+		)
+	}
+
+	if o.HorizontalAccuracy < 0 || o.HorizontalAccuracy > maxHorizontalAccuracy {
+		return fmt.Errorf("horizontal accuracy must be between 0 and %d", maxHorizontalAccuracy)
+	}
+
+	if o.Heading < 0 || o.Heading > maxHeading {
+		return fmt.Errorf("heading must be between 1 and %d", maxHeading)
+	}
+
+	if o.ProximityAlertRadius < 0 || o.ProximityAlertRadius > maxProximityAlertRadius {
+		return fmt.Errorf("proximity alert radius must be between 1 and %d", maxProximityAlertRadius)
+	}
+
+	return nil
+}
+
+// Use this method to send point on the map.
+// On success, the sent Message is returned.
+func (b *Bot) SendLocation(options SendLocationOptions) (types.Message, error) {
+	if err := options.validate(); err != nil {
+		return types.Message{}, err
+	}
+
+	return request[types.Message](b, sendLocationUrl, options)
+}
+
+type SendVenueOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread (topic)
+	// of the forum; for forum supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Identifier of the direct messages topic to which
+	// the message will be sent; required if the message
+	// is sent to a direct messages chat
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
+
+	// Latitude of the venue
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the venue
+	Longitude float64 `json:"longitude"`
+
+	// Name of the venue
+	Title string `json:"title" validate:"required"`
+
+	// Address of the venue
+	Address string `json:"address" validate:"required"`
+
+	// Foursquare identifier of the venue
+	FoursquareID string `json:"foursquare_id,omitempty"`
+
+	// Foursquare type of the venue, if known. (For example,
+	// “arts_entertainment/default”, “arts_entertainment/aquarium”
+	// or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+
+	// Google Places identifier of the venue
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+
+	// Google Places type of the venue.
+	// (https://developers.google.com/places/web-service/supported_types)
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+
+	// Sends the message silently. Users will
+	// receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Pass True to allow up to 1000 messages per second, ignoring
+	// broadcasting limits for a fee of 0.1 Telegram Stars per message.
+	// The relevant Stars will be withdrawn from the bot's balance
+	AllowPaidBroadcast bool `json:"allow_paid_broadcast,omitempty"`
+
+	// Unique identifier of the message effect to be added
+	// to the message; for private chats only
+	MessageEffectID string `json:"message_effect_id,omitempty"`
+
+	// A JSON-serialized object containing the parameters of the
+	// suggested post to send; for direct messages chats only.
+	SuggestedPostParameters *types.SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+
+	// Description of the message to reply to
+	ReplyParameters *types.ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// Additional interface options. A JSON-serialized object for
+	// an inline keyboard, custom reply keyboard, instructions to
+	// remove a reply keyboard or to force a reply from the user
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// Use this method to send information about a venue.
+// On success, the sent Message is returned.
+func (b *Bot) SendVenue(options SendVenueOptions) (types.Message, error) {
+	if err := utils.ValidateStruct(options); err != nil {
+		return types.Message{}, err
+	}
+
+	return request[types.Message](b, sendVenueUrl, options)
+}
+
+type SendContactOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread (topic)
+	// of the forum; for forum supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Identifier of the direct messages topic to which
+	// the message will be sent; required if the message
+	// is sent to a direct messages chat
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
+
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number" validate:"required"`
+
+	// Contact's first name
+	FirstName string `json:"first_name" validate:"required"`
+
+	// Contact's last name
+	LastName string `json:"last_name,omitempty"`
+
+	// Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+
+	// Sends the message silently. Users will
+	// receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Pass True to allow up to 1000 messages per second, ignoring
+	// broadcasting limits for a fee of 0.1 Telegram Stars per message.
+	// The relevant Stars will be withdrawn from the bot's balance
+	AllowPaidBroadcast bool `json:"allow_paid_broadcast,omitempty"`
+
+	// Unique identifier of the message effect to be added
+	// to the message; for private chats only
+	MessageEffectID string `json:"message_effect_id,omitempty"`
+
+	// A JSON-serialized object containing the parameters of the
+	// suggested post to send; for direct messages chats only.
+	SuggestedPostParameters *types.SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+
+	// Description of the message to reply to
+	ReplyParameters *types.ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// Additional interface options. A JSON-serialized object for
+	// an inline keyboard, custom reply keyboard, instructions to
+	// remove a reply keyboard or to force a reply from the user
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// Use this method to send phone contacts.
+// On success, the sent Message is returned.
+func (b *Bot) SendContact(options SendContactOptions) (types.Message, error) {
+	if err := utils.ValidateStruct(options); err != nil {
+		return types.Message{}, err
+	}
+
+	if len(options.Vcard) > maxVcardSize {
+		return types.Message{}, fmt.Errorf(
+			"vcard is too large: %d bytes (max: %d)", len(options.Vcard), maxVcardSize,
+		)
+	}
+
+	return request[types.Message](b, sendContactUrl, options)
+}
+
+type SendDiceOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread (topic)
+	// of the forum; for forum supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Identifier of the direct messages topic to which
+	// the message will be sent; required if the message
+	// is sent to a direct messages chat
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
+
+	// Emoji on which the dice throw animation is based, one of
+	// the DiceEmoji constants. Dice can have values 1-6 for “🎲”,
+	// “🎯” and “🎳”, values 1-5 for “🏀” and “⚽”, and values 1-64
+	// for “🎰”. Defaults to “🎲”
+	Emoji string `json:"emoji,omitempty"`
+
+	// Sends the message silently. Users will
+	// receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Protects the contents of the sent message from forwarding
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Pass True to allow up to 1000 messages per second, ignoring
+	// broadcasting limits for a fee of 0.1 Telegram Stars per message.
+	// The relevant Stars will be withdrawn from the bot's balance
+	AllowPaidBroadcast bool `json:"allow_paid_broadcast,omitempty"`
+
+	// Unique identifier of the message effect to be added
+	// to the message; for private chats only
+	MessageEffectID string `json:"message_effect_id,omitempty"`
+
+	// A JSON-serialized object containing the parameters of the
+	// suggested post to send; for direct messages chats only.
+	SuggestedPostParameters *types.SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+
+	// Description of the message to reply to
+	ReplyParameters *types.ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// Additional interface options. A JSON-serialized object for
+	// an inline keyboard, custom reply keyboard, instructions to
+	// remove a reply keyboard or to force a reply from the user
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// Use this method to send an animated emoji that will display a
+// random value. On success, the sent Message is returned.
+func (b *Bot) SendDice(options SendDiceOptions) (types.Message, error) {
+	if err := utils.ValidateStruct(options); err != nil {
+		return types.Message{}, err
+	}
+
+	switch options.Emoji {
+	case "", types.DiceEmojiDice, types.DiceEmojiDarts, types.DiceEmojiBowling,
+		types.DiceEmojiBasketball, types.DiceEmojiFootball, types.DiceEmojiSlotMachine:
+	default:
+		return types.Message{}, fmt.Errorf("unsupported dice emoji: %q", options.Emoji)
+	}
+
+	return request[types.Message](b, sendDiceUrl, options)
+}
+
+// LiveLocation is a live location message kept up to date with the
+// coordinates received from a channel. Create it with StartLiveLocation.
+type LiveLocation struct {
+	// The sent live location message
+	Message types.Message
+
+	bot                  *Bot
+	businessConnectionID string
+	target               MessageTarget
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+// StartLiveLocation sends a live location and edits it with the
+// coordinates received from locations. Edits are made at most once
+// per interval, with the latest coordinates only; a zero interval
+// defaults to 3 seconds. LivePeriod of the options must be set.
+//
+// The live location is stopped when locations is closed, ctx is
+// done or Stop is called; the latest coordinates are sent before
+// that, unless flood control is in effect. It also ends without an
+// error once the message can no longer be edited, e.g. the live
+// period has expired.
+//
+// Failed edits are repeated on the next tick, and edits are paused
+// while flood control is in effect. Coordinates rejected by Telegram
+// are skipped. After several failed edits in a row the live location
+// is stopped, and Err returns the last error.
+func (b *Bot) StartLiveLocation(
+	ctx context.Context, options SendLocationOptions,
+	locations <-chan types.Location, interval time.Duration,
+) (*LiveLocation, error) {
+	if options.LivePeriod == 0 {
+		return nil, errors.New("live period is required for a live location")
+	}
+
+	if interval <= 0 {
+		interval = defaultLiveLocationInterval
+	}
+
+	msg, err := b.SendLocation(options)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	l := &LiveLocation{
+		Message:              msg,
+		bot:                  b,
+		businessConnectionID: options.BusinessConnectionID,
+		target:               ChatMessage(msg.Chat.ChatID(), msg.MessageID),
+		cancel:               cancel,
+		done:                 make(chan struct{}),
+	}
+
+	go l.run(ctx, locations, interval)
+
+	return l, nil
+}
+
+// Done is closed when the live location has stopped.
+func (l *LiveLocation) Done() <-chan struct{} {
+	return l.done
+}
+
+// Err returns the error that stopped the live location, if any.
+// It must be called after Done is closed.
+func (l *LiveLocation) Err() error {
+	return l.err
+}
+
+// Stop stops updating the live location and waits until it's stopped.
+func (l *LiveLocation) Stop() error {
+	l.cancel()
+	<-l.done
+
+	return l.err
+}
+
+func (l *LiveLocation) run(ctx context.Context, locations <-chan types.Location, interval time.Duration) {
+	defer close(l.done)
+	defer l.cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var (
+		latest   *types.Location
+		failures int
+
+		// Edits are paused until then by flood control
+		resume time.Time
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			l.err = l.finish(latest, resume)
+			return
+
+		case location, ok := <-locations:
+			if !ok {
+				l.err = l.finish(latest, resume)
+				return
+			}
+
+			latest = &location
+
+		case <-ticker.C:
+			if latest == nil || time.Now().Before(resume) {
+				continue
+			}
+
+			err := l.edit(*latest)
+
+			switch {
+			case err == nil:
+				latest, failures = nil, 0
+
+			case api.IsMessageNotEditable(err):
+				// The live period has expired or the message was deleted
+				return
+
+			default:
+				failures++
+				if failures >= maxLiveLocationFailures {
+					l.err = errors.Join(err, l.stop())
+					return
+				}
+
+				if wait := api.RetryAfter(err); wait > 0 {
+					resume = time.Now().Add(wait)
+				} else if api.IsBadRequest(err) {
+					latest = nil
+				}
+			}
+		}
+	}
+}
+
+// finish sends the latest coordinates, if any, and stops the live
+// location. Under flood control the coordinates are dropped rather
+// than waited for, so stopping isn't delayed.
+func (l *LiveLocation) finish(latest *types.Location, resume time.Time) error {
+	if latest != nil && !time.Now().Before(resume) {
+		err := l.edit(*latest)
+
+		switch {
+		case api.IsMessageNotEditable(err):
+			return nil
+		case err != nil && api.RetryAfter(err) == 0:
+			return errors.Join(err, l.stop())
+		}
+	}
+
+	return l.stop()
+}
+
+func (l *LiveLocation) edit(location types.Location) error {
+	_, err := l.bot.EditMessageLiveLocation(EditMessageLiveLocationOptions{
+		BusinessConnectionID: l.businessConnectionID,
+		MessageTarget:        l.target,
+		Latitude:             location.Latitude,
+		Longitude:            location.Longitude,
+		HorizontalAccuracy:   location.HorizontalAccuracy,
+		Heading:              location.Heading,
+		ProximityAlertRadius: location.ProximityAlertRadius,
+	})
+
+	if api.IsMessageNotModified(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (l *LiveLocation) stop() error {
+	_, err := l.bot.StopMessageLiveLocation(StopMessageLiveLocationOptions{
+		BusinessConnectionID: l.businessConnectionID,
+		MessageTarget:        l.target,
+	})
+
+	// The live period has already expired
+	if api.IsMessageNotEditable(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,87 @@
+package bot
+
+import (
+	"fmt"
+
+	"github.com/purkhanov/gogram/format"
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+// SendLongMessage sends text of any length as several messages of
+// at most 4096 characters. Text is split on paragraph, line or word
+// boundaries, and formatting entities cut by a split are kept in
+// both parts. Text that fits into one message is sent as is. Longer
+// text in a parse mode is converted to entities with format.ParseText
+// before splitting, so it is parsed by the library, not by Telegram.
+//
+// Reply parameters and the message effect are applied to the first
+// message only and the reply markup to the last one. On error, the
+// messages sent so far are returned along with the error.
+//
+// Long media captions are split with format.SplitCaption: the first
+// chunk goes to the media and the rest can be sent as messages.
+func (b *Bot) SendLongMessage(params SendMessageOptions) ([]types.Message, error) {
+	if err := utils.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+
+	// Markup only adds characters, so the text fits
+	// if it does before parsing
+	if format.UTF16Len(params.Text) <= format.MaxMessageLength {
+		return b.sendWhole(params)
+	}
+
+	text, entities := params.Text, params.Entities
+
+	if params.ParseMode != "" {
+		var err error
+
+		text, entities, err = format.ParseText(params.ParseMode, params.Text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s text: %w", params.ParseMode, err)
+		}
+	}
+
+	if format.UTF16Len(text) <= format.MaxMessageLength {
+		return b.sendWhole(params)
+	}
+
+	chunks := format.Split(text, entities, format.MaxMessageLength)
+	messages := make([]types.Message, 0, len(chunks))
+
+	for i, chunk := range chunks {
+		chunkParams := params
+		chunkParams.Text = chunk.Text
+		chunkParams.Entities = chunk.Entities
+		chunkParams.ParseMode = ""
+
+		if i > 0 {
+			chunkParams.ReplyParameters = nil
+			chunkParams.MessageEffectID = ""
+		}
+
+		if i < len(chunks)-1 {
+			chunkParams.ReplyMarkup = nil
+		}
+
+		msg, err := b.SendMessage(chunkParams)
+		if err != nil {
+			return messages, fmt.Errorf("failed to send part %d of %d: %w", i+1, len(chunks), err)
+		}
+
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
+
+// sendWhole sends text that needs no splitting, leaving parsing to Telegram.
+func (b *Bot) sendWhole(params SendMessageOptions) ([]types.Message, error) {
+	msg, err := b.SendMessage(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return []types.Message{msg}, nil
+}
@@ -21,7 +21,7 @@ const (
 type SendInvoiceOptions struct {
 	// Unique identifier for the target chat or username
 	// of the target channel (in the format @channelusername)
-	ChatID uint `json:"chat_id" validate:"required"`
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
 
 	// Product name, 1-32 characters
 	Title string `json:"title" validate:"required"`
@@ -45,11 +45,11 @@ type SendInvoiceOptions struct {
 
 	// Unique identifier for the target message thread (topic)
 	// of the forum; for forum supergroups only
-	MessageThreadID uint `json:"message_thread_id,omitempty"`
+	MessageThreadID int `json:"message_thread_id,omitempty"`
 
 	// Identifier of the direct messages topic to which the message will
 	// be sent; required if the message is sent to a direct messages chat
-	DirectMessagesTopicID uint `json:"direct_messages_topic_id,omitempty"`
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
 
 	// Payment provider token, obtained via @BotFather. Pass
 	// an empty string for payments in Telegram Stars.
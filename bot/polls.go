@@ -0,0 +1,209 @@
+package bot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const (
+	sendPollUrl = "/sendPoll"
+
+	maxPollQuestionLength    = 300
+	maxPollOptionLength      = 100
+	maxPollExplanationLength = 200
+	minPollOptions           = 2
+	maxPollOptions           = 12
+	minPollOpenPeriod        = 5 * time.Second
+	maxPollOpenPeriod        = 600 * time.Second
+)
+
+type SendPollOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername).
+	// Polls can't be sent to channel direct messages chats.
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Unique identifier for the target message thread (topic)
+	// of the forum; for forum supergroups only
+	MessageThreadID int `json:"message_thread_id,omitempty"`
+
+	// Poll question, 1-300 characters
+	Question string `json:"question" validate:"required"`
+
+	// Mode for parsing entities in the question. See formatting
+	// options for more details. Currently, only custom emoji
+	// entities are allowed
+	QuestionParseMode types.ParseMode `json:"question_parse_mode,omitempty"`
+
+	// A JSON-serialized list of special entities that appear in the
+	// poll question. It can be specified instead of question_parse_mode
+	QuestionEntities []types.MessageEntity `json:"question_entities,omitempty"`
+
+	// A JSON-serialized list of 2-12 answer options
+	Options []types.InputPollOption `json:"options" validate:"required"`
+
+	// True, if the poll needs to be anonymous, defaults to True
+	IsAnonymous *bool `json:"is_anonymous,omitempty"`
+
+	// Poll type, PollTypeQuiz or PollTypeRegular,
+	// defaults to PollTypeRegular
+	Type string `json:"type,omitempty"`
+
+	// True, if the poll allows multiple answers, ignored
+	// for polls in quiz mode, defaults to False
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers,omitempty"`
+
+	// 0-based identifier of the correct answer option,
+	// required for polls in quiz mode
+	CorrectOptionID int `json:"-"`
+
+	// Text that is shown when a user chooses an incorrect answer or
+	// taps on the lamp icon in a quiz-style poll, 0-200 characters
+	// with at most 2 line feeds after entities parsing
+	Explanation string `json:"explanation,omitempty"`
+
+	// Mode for parsing entities in the explanation.
+	// See formatting options for more details.
+	ExplanationParseMode types.ParseMode `json:"explanation_parse_mode,omitempty"`
+
+	// A JSON-serialized list of special entities that appear in
+	// the poll explanation. It can be specified instead of
+	// explanation_parse_mode
+	ExplanationEntities []types.MessageEntity `json:"explanation_entities,omitempty"`
+
+	// Amount of time the poll will be active after creation,
+	// 5-600 seconds. Can't be used together with CloseDate.
+	OpenPeriod time.Duration `json:"-"`
+
+	// Point in time when the poll will be automatically closed.
+	// Must be at least 5 and no more than 600 seconds in the future.
+	// Can't be used together with OpenPeriod.
+	CloseDate time.Time `json:"-"`
+
+	// Pass True if the poll needs to be immediately closed.
+	// This can be useful for poll preview.
+	IsClosed bool `json:"is_closed,omitempty"`
+
+	// Sends the message silently. Users will
+	// receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Pass True to allow up to 1000 messages per second, ignoring
+	// broadcasting limits for a fee of 0.1 Telegram Stars per message.
+	// The relevant Stars will be withdrawn from the bot's balance
+	AllowPaidBroadcast bool `json:"allow_paid_broadcast,omitempty"`
+
+	// Unique identifier of the message effect to be added
+	// to the message; for private chats only
+	MessageEffectID string `json:"message_effect_id,omitempty"`
+
+	// Description of the message to reply to
+	ReplyParameters *types.ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// Additional interface options. A JSON-serialized object for
+	// an inline keyboard, custom reply keyboard, instructions to
+	// remove a reply keyboard or to force a reply from the user
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+func (o SendPollOptions) MarshalJSON() ([]byte, error) {
+	type options SendPollOptions
+
+	var correctOptionID *int
+	if o.Type == types.PollTypeQuiz {
+		correctOptionID = &o.CorrectOptionID
+	}
+
+	return json.Marshal(struct {
+		options
+		CorrectOptionID *int  `json:"correct_option_id,omitempty"`
+		OpenPeriod      int64 `json:"open_period,omitempty"`
+		CloseDate       int64 `json:"close_date,omitempty"`
+	}{options(o), correctOptionID, int64(o.OpenPeriod / time.Second), unixDate(o.CloseDate)})
+}
+
+func (o SendPollOptions) validate() error {
+	if err := utils.ValidateStruct(o); err != nil {
+		return err
+	}
+
+	if err := checkLength("question", o.Question, maxPollQuestionLength); err != nil {
+		return err
+	}
+
+	if len(o.Options) < minPollOptions || len(o.Options) > maxPollOptions {
+		return fmt.Errorf(
+			"poll must have %d-%d options, got %d",
+			minPollOptions, maxPollOptions, len(o.Options),
+		)
+	}
+
+	for _, option := range o.Options {
+		if option.Text == "" {
+			return errors.New("poll option text is empty")
+		}
+
+		if err := checkLength("poll option", option.Text, maxPollOptionLength); err != nil {
+			return err
+		}
+	}
+
+	switch o.Type {
+	case "", types.PollTypeRegular:
+	case types.PollTypeQuiz:
+		if o.CorrectOptionID < 0 || o.CorrectOptionID >= len(o.Options) {
+			return fmt.Errorf("correct option %d is out of range", o.CorrectOptionID)
+		}
+	default:
+		return fmt.Errorf("unknown poll type: %q", o.Type)
+	}
+
+	if err := checkLength("explanation", o.Explanation, maxPollExplanationLength); err != nil {
+		return err
+	}
+
+	if o.OpenPeriod != 0 && !o.CloseDate.IsZero() {
+		return errors.New("open period and close date can't be used together")
+	}
+
+	if o.OpenPeriod != 0 && (o.OpenPeriod < minPollOpenPeriod || o.OpenPeriod > maxPollOpenPeriod) {
+		return fmt.Errorf(
+			"open period must be between %s and %s",
+			minPollOpenPeriod, maxPollOpenPeriod,
+		)
+	}
+
+	if !o.CloseDate.IsZero() {
+		if until := time.Until(o.CloseDate); until < minPollOpenPeriod || until > maxPollOpenPeriod {
+			return fmt.Errorf(
+				"close date must be between %s and %s in the future",
+				minPollOpenPeriod, maxPollOpenPeriod,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Use this method to send a native poll.
+// On success, the sent Message is returned.
+// Use StopPoll to close the poll before its time.
+func (b *Bot) SendPoll(options SendPollOptions) (types.Message, error) {
+	if err := options.validate(); err != nil {
+		return types.Message{}, err
+	}
+
+	return request[types.Message](b, sendPollUrl, options)
+}
@@ -0,0 +1,171 @@
+package bot
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+const (
+	getMeUrl                 = "/getMe"
+	logOutUrl                = "/logOut"
+	closeUrl                 = "/close"
+	setMyNameUrl             = "/setMyName"
+	getMyNameUrl             = "/getMyName"
+	setMyDescriptionUrl      = "/setMyDescription"
+	getMyDescriptionUrl      = "/getMyDescription"
+	setMyShortDescriptionUrl = "/setMyShortDescription"
+	getMyShortDescriptionUrl = "/getMyShortDescription"
+
+	maxNameLength             = 64
+	maxDescriptionLength      = 512
+	maxShortDescriptionLength = 120
+	maxCustomTitleLength      = 16
+)
+
+// A simple method for testing your bot's authentication token.
+// Requires no parameters. Returns basic information about
+// the bot in form of a User object.
+//
+// The result is requested once and cached for the lifetime of the Bot.
+func (b *Bot) GetMe() (types.User, error) {
+	b.meMu.Lock()
+	defer b.meMu.Unlock()
+
+	if b.me != nil {
+		return *b.me, nil
+	}
+
+	me, err := request[types.User](b, getMeUrl, struct{}{})
+	if err != nil {
+		return types.User{}, err
+	}
+
+	b.me = &me
+
+	return me, nil
+}
+
+// Username returns the bot's username without the leading "@".
+func (b *Bot) Username() (string, error) {
+	me, err := b.GetMe()
+	if err != nil {
+		return "", err
+	}
+
+	return me.Username, nil
+}
+
+// Use this method to log out from the cloud Bot API server before
+// launching the bot locally. You must log out the bot before running
+// it locally, otherwise there is no guarantee that the bot will receive
+// updates. After a successful call, you can immediately log in on a
+// local server, but will not be able to log in back to the cloud Bot
+// API server for 10 minutes. Returns True on success.
+func (b *Bot) LogOut() error {
+	_, err := request[bool](b, logOutUrl, struct{}{})
+	return err
+}
+
+// Use this method to close the bot instance before moving it from one
+// local server to another. You need to delete the webhook before calling
+// this method to ensure that the bot isn't launched again after server
+// restart. The method will return error 429 in the first 10 minutes
+// after the bot is launched. Returns True on success.
+func (b *Bot) Close() error {
+	_, err := request[bool](b, closeUrl, struct{}{})
+	return err
+}
+
+// Use this method to change the bot's name. Pass an empty name to
+// remove the dedicated name for the given language. A two-letter ISO
+// 639-1 language code sets the name for users with that language;
+// an empty one sets the name for all users. Returns True on success.
+func (b *Bot) SetMyName(name, languageCode string) error {
+	if err := checkLength("name", name, maxNameLength); err != nil {
+		return err
+	}
+
+	params := languageParams(languageCode)
+	params["name"] = name
+
+	_, err := request[bool](b, setMyNameUrl, params)
+	return err
+}
+
+// Use this method to get the current bot name for the given user
+// language, a two-letter ISO 639-1 language code or an empty string.
+// Returns BotName on success.
+func (b *Bot) GetMyName(languageCode string) (types.BotName, error) {
+	return request[types.BotName](b, getMyNameUrl, languageParams(languageCode))
+}
+
+// Use this method to change the bot's description, which is shown
+// in the chat with the bot if the chat is empty. Pass an empty
+// description to remove the dedicated description for the given
+// language. A two-letter ISO 639-1 language code sets the description
+// for users with that language; an empty one sets the description for
+// all users. Returns True on success.
+func (b *Bot) SetMyDescription(description, languageCode string) error {
+	if err := checkLength("description", description, maxDescriptionLength); err != nil {
+		return err
+	}
+
+	params := languageParams(languageCode)
+	params["description"] = description
+
+	_, err := request[bool](b, setMyDescriptionUrl, params)
+	return err
+}
+
+// Use this method to get the current bot description for the given
+// user language, a two-letter ISO 639-1 language code or an empty
+// string. Returns BotDescription on success.
+func (b *Bot) GetMyDescription(languageCode string) (types.BotDescription, error) {
+	return request[types.BotDescription](b, getMyDescriptionUrl, languageParams(languageCode))
+}
+
+// Use this method to change the bot's short description, which is
+// shown on the bot's profile page and is sent together with the link
+// when users share the bot. Pass an empty short description to remove
+// the dedicated short description for the given language. A two-letter
+// ISO 639-1 language code sets the short description for users with
+// that language; an empty one sets it for all users. Returns True on success.
+func (b *Bot) SetMyShortDescription(shortDescription, languageCode string) error {
+	err := checkLength("short description", shortDescription, maxShortDescriptionLength)
+	if err != nil {
+		return err
+	}
+
+	params := languageParams(languageCode)
+	params["short_description"] = shortDescription
+
+	_, err = request[bool](b, setMyShortDescriptionUrl, params)
+	return err
+}
+
+// Use this method to get the current bot short description for the
+// given user language, a two-letter ISO 639-1 language code or an
+// empty string. Returns BotShortDescription on success.
+func (b *Bot) GetMyShortDescription(languageCode string) (types.BotShortDescription, error) {
+	return request[types.BotShortDescription](
+		b, getMyShortDescriptionUrl, languageParams(languageCode),
+	)
+}
+
+func languageParams(languageCode string) map[string]string {
+	if languageCode == "" {
+		return map[string]string{}
+	}
+
+	return map[string]string{"language_code": languageCode}
+}
+
+func checkLength(field, value string, limit int) error {
+	if n := utf8.RuneCountInString(value); n > limit {
+		return fmt.Errorf("%s is too long: %d characters (max: %d)", field, n, limit)
+	}
+
+	return nil
+}
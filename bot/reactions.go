@@ -0,0 +1,53 @@
+package bot
+
+import (
+	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
+)
+
+const setMessageReactionUrl = "/setMessageReaction"
+
+type SetMessageReactionOptions struct {
+	// Unique identifier for the target chat or username of the
+	// target channel (in the format @channelusername)
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
+
+	// Identifier of the target message. If the message belongs
+	// to a media group, the reaction is set to the first
+	// non-deleted message in the group instead.
+	MessageID int `json:"message_id" validate:"required"`
+
+	// A JSON-serialized list of reaction types to set on the message.
+	// Currently, as non-premium users, bots can set up to one reaction
+	// per message. A custom emoji reaction can be used if it is either
+	// already present on the message or explicitly allowed by chat
+	// administrators. Paid reactions can't be used by bots.
+	// Leave empty to remove the reactions of the bot.
+	Reaction []types.ReactionType `json:"reaction,omitempty"`
+
+	// Pass True to set the reaction with a big animation
+	IsBig bool `json:"is_big,omitempty"`
+}
+
+// Use this method to change the chosen reactions on a message. Service
+// messages of some types can't be reacted to. Automatically forwarded
+// messages from a channel to its discussion group have the same
+// available reactions as messages in the channel. Bots can't use paid
+// reactions. Returns True on success.
+func (b *Bot) SetMessageReaction(options SetMessageReactionOptions) error {
+	if err := utils.ValidateStruct(options); err != nil {
+		return err
+	}
+
+	_, err := request[bool](b, setMessageReactionUrl, options)
+	return err
+}
+
+// React sets the emoji reaction of the bot on the message.
+func (b *Bot) React(chatID types.ChatID, messageID int, emoji string) error {
+	return b.SetMessageReaction(SetMessageReactionOptions{
+		ChatID:    chatID,
+		MessageID: messageID,
+		Reaction:  []types.ReactionType{types.EmojiReaction(emoji)},
+	})
+}
@@ -24,16 +24,16 @@ type SendMessageOptions struct {
 
 	// Unique identifier for the target chat or username of the
 	// target channel (in the format @channelusername)
-	ChatID uint `json:"chat_id" validate:"required"`
+	ChatID types.ChatID `json:"chat_id" validate:"required"`
 
 	// Unique identifier for the target message thread (topic)
 	// of the forum; for forum supergroups only
-	MessageThreadID uint `json:"message_thread_id,omitempty"`
+	MessageThreadID int `json:"message_thread_id,omitempty"`
 
 	// dentifier of the direct messages topic to which
 	// the message will be sent; required if the message
 	// is sent to a direct messages chat
-	DirectMessagesTopicID uint `json:"direct_messages_topic_id,omitempty"`
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
 
 	// Text of the message to be sent, 1-4096
 	// characters after entities parsing
@@ -41,7 +41,7 @@ type SendMessageOptions struct {
 
 	// Mode for parsing entities in the message text.
 	// See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode types.ParseMode `json:"parse_mode,omitempty"`
 
 	// A JSON-serialized list of special entities that appear in
 	// message text, which can be specified instead of parse_mode
@@ -88,34 +88,7 @@ func (b *Bot) SendMessage(params SendMessageOptions) (types.Message, error) {
 		return types.Message{}, err
 	}
 
-	data, err := json.Marshal(params)
-	if err != nil {
-		return types.Message{}, fmt.Errorf("failed to marshal params: %w", err)
-	}
-
-	c, cancel := context.WithTimeout(b.ctx, httpRequestTimeout)
-	defer cancel()
-
-	resp, err := b.api.DoRequestWithContextAndData(
-		c, http.MethodPost, b.urlWithToken+sendMessageUrl, data,
-	)
-	if err != nil {
-		return types.Message{}, err
-	}
-
-	var result api.APIResponse[types.Message]
-
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return types.Message{}, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	if !result.Ok {
-		return result.Result, fmt.Errorf(
-			"telegram API error: code %d - %s", result.ErrorCode, result.Description,
-		)
-	}
-
-	return result.Result, nil
+	return request[types.Message](b, sendMessageUrl, params)
 }
 
 type SendVoiceOptions struct {
@@ -125,16 +98,16 @@ type SendVoiceOptions struct {
 
 	// Unique identifier for the target chat or username of the
 	// target channel (in the format @channelusername)
-	ChatID uint `json:"chat_id"`
+	ChatID types.ChatID `json:"chat_id"`
 
 	// Unique identifier for the target message thread (topic)
 	// of the forum; for forum supergroups only
-	MessageThreadID uint `json:"message_thread_id,omitempty"`
+	MessageThreadID int `json:"message_thread_id,omitempty"`
 
 	// dentifier of the direct messages topic to which
 	// the message will be sent; required if the message
 	// is sent to a direct messages chat
-	DirectMessagesTopicID uint `json:"direct_messages_topic_id,omitempty"`
+	DirectMessagesTopicID int `json:"direct_messages_topic_id,omitempty"`
 
 	// Audio file to send. Pass a file_id as String to send a file
 	// that exists on the Telegram servers (recommended), pass an
@@ -150,7 +123,7 @@ type SendVoiceOptions struct {
 	// Mode for parsing entities in the message text.
 	// See formatting options (https://core.telegram.org/bots/api#formatting-options)
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode types.ParseMode `json:"parse_mode,omitempty"`
 
 	// A JSON-serialized list of special entities that appear in the
 	// caption, which can be specified instead of parse_mode
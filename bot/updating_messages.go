@@ -3,12 +3,13 @@ package bot
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-
 	"net/http"
 
 	"github.com/purkhanov/gogram/api"
 	"github.com/purkhanov/gogram/types"
+	"github.com/purkhanov/gogram/utils"
 )
 
 // Updating messages
@@ -19,117 +20,139 @@ import (
 // reduce clutter in conversations with regular chat bots.
 
 const (
-	deleteMessageUrl  = "/deleteMessage"
-	deleteMessagesUrl = "/deleteMessages"
+	editMessageTextUrl         = "/editMessageText"
+	editMessageCaptionUrl      = "/editMessageCaption"
+	editMessageMediaUrl        = "/editMessageMedia"
+	editMessageLiveLocationUrl = "/editMessageLiveLocation"
+	stopMessageLiveLocationUrl = "/stopMessageLiveLocation"
+	editMessageChecklistUrl    = "/editMessageChecklist"
+	editMessageReplyMarkupUrl  = "/editMessageReplyMarkup"
+	stopPollUrl                = "/stopPoll"
+	deleteMessageUrl           = "/deleteMessage"
+	deleteMessagesUrl          = "/deleteMessages"
 )
 
-type EditMessageTextOptions struct {
-	// Unique identifier of the business connection on
-	// behalf of which the message to be edited was sent
-	BusinessConnectionID string `json:"business_connection_id,omitempty"`
-
+// MessageTarget identifies the message to edit. Either ChatID
+// and MessageID or InlineMessageID must be specified.
+type MessageTarget struct {
 	// Required if inline_message_id is not specified.
 	// Unique identifier for the target chat or username
 	// of the target channel (in the format @channelusername)
-	ChatID uint `json:"chat_id,omitempty"`
+	ChatID types.ChatID `json:"chat_id,omitzero"`
 
 	// Required if inline_message_id is not specified.
 	// Identifier of the message to edit
-	MessageID uint `json:"message_id,omitempty"`
+	MessageID int `json:"message_id,omitempty"`
 
 	// Required if chat_id and message_id are not specified.
 	// Identifier of the inline message
 	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
 
-	// New text of the message, 1-4096
-	// characters after entities parsing
-	Text string `json:"text" validate:"required"`
+// ChatMessage returns the target of a message sent to a chat.
+func ChatMessage(chatID types.ChatID, messageID int) MessageTarget {
+	return MessageTarget{ChatID: chatID, MessageID: messageID}
+}
 
-	// Mode for parsing entities in the message text.
-	// See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+// InlineMessage returns the target of a message sent via the bot in inline mode.
+func InlineMessage(inlineMessageID string) MessageTarget {
+	return MessageTarget{InlineMessageID: inlineMessageID}
+}
 
-	// A JSON-serialized list of special entities that appear in
-	// message text, which can be specified instead of parse_mode
-	Entities []types.MessageEntity `json:"entities,omitempty"`
+// CallbackTarget returns the target of the message with the button
+// that originated the callback query. It reports false if the
+// message is unknown.
+func CallbackTarget(cb *types.CallbackQuery) (MessageTarget, bool) {
+	if cb.InlineMessageID != "" {
+		return InlineMessage(cb.InlineMessageID), true
+	}
 
-	// link_preview_options
-	LinkPreviewOptions *types.LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	if cb.Message == nil || cb.Message.Chat() == nil {
+		return MessageTarget{}, false
+	}
 
-	// A JSON-serialized object for an inline keyboard.
-	ReplyMarkup *types.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	return ChatMessage(cb.Message.Chat().ChatID(), cb.Message.ID()), true
 }
 
-// Use this method to edit text and game messages. On success, if the
-// edited message is not an inline message, the edited Message is
-// returned, otherwise True is returned. Note that business messages
-// that were not sent by the bot and do not contain an inline keyboard
-// can only be edited within 48 hours from the time they were sent.
-func (b *Bot) EditMessageText(options EditMessageTextOptions) (bool, error) {
-	if options.InlineMessageID != "" {
-		if options.ChatID != 0 || options.MessageID != 0 {
-			return false, fmt.Errorf(
+// IsInline reports whether the target is an inline message.
+func (t MessageTarget) IsInline() bool {
+	return t.InlineMessageID != ""
+}
+
+func (t MessageTarget) validate() error {
+	if t.IsInline() {
+		if !t.ChatID.IsZero() || t.MessageID != 0 {
+			return errors.New(
 				"ChatID and MessageID should not be specified for inline messages",
 			)
 		}
-	}
 
-	if options.ChatID == 0 {
-		return false, fmt.Errorf("ChatID is required for non-inline messages")
+		return nil
 	}
 
-	if options.MessageID == 0 {
-		return false, fmt.Errorf("MessageID is required for non-inline messages")
+	if t.ChatID.IsZero() {
+		return errors.New("ChatID is required for non-inline messages")
 	}
 
-	if options.Text == "" {
-		return false, fmt.Errorf("text is required")
+	if t.MessageID == 0 {
+		return errors.New("MessageID is required for non-inline messages")
 	}
 
-	data, err := json.Marshal(options)
-	if err != nil {
-		return false, err
+	return nil
+}
+
+// validateChatMessage is used by methods
+// that don't support inline messages.
+func (t MessageTarget) validateChatMessage() error {
+	if t.IsInline() {
+		return errors.New("inline messages are not supported by this method")
 	}
 
-	requestUrl := b.urlWithToken + "/editMessageText"
+	return t.validate()
+}
 
-	resp, err := b.api.DoRequestWithTimeout(http.MethodPost, requestUrl, data)
-	if err != nil {
-		return false, err
-	}
+type EditMessageTextOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message to be edited was sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
 
-	var msgResult api.APIResponse[bool]
-	var boolResult api.APIResponse[bool]
+	// The message to edit
+	MessageTarget
 
-	if options.InlineMessageID != "" {
-		if err := json.Unmarshal(resp, &boolResult); err != nil {
-			return false, err
-		}
+	// New text of the message, 1-4096
+	// characters after entities parsing
+	Text string `json:"text" validate:"required"`
 
-		if !boolResult.Ok {
-			return false, fmt.Errorf(
-				"telegram API error: code %d - %s",
-				boolResult.ErrorCode, boolResult.Description,
-			)
-		}
+	// Mode for parsing entities in the message text.
+	// See formatting options for more details.
+	ParseMode types.ParseMode `json:"parse_mode,omitempty"`
+
+	// A JSON-serialized list of special entities that appear in
+	// message text, which can be specified instead of parse_mode
+	Entities []types.MessageEntity `json:"entities,omitempty"`
 
-		return boolResult.Result, nil
+	// Link preview generation options for the message
+	LinkPreviewOptions *types.LinkPreviewOptions `json:"link_preview_options,omitempty"`
 
-	} else {
-		if err := json.Unmarshal(resp, &msgResult); err != nil {
-			return false, err
-		}
+	// A JSON-serialized object for an inline keyboard.
+	ReplyMarkup *types.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
 
-		if !msgResult.Ok {
-			return false, fmt.Errorf(
-				"telegram API error: code %d - %s",
-				msgResult.ErrorCode, msgResult.Description,
-			)
-		}
+// Use this method to edit text and game messages. On success, if the
+// edited message is not an inline message, the edited Message is
+// returned, otherwise True is returned. Note that business messages
+// that were not sent by the bot and do not contain an inline keyboard
+// can only be edited within 48 hours from the time they were sent.
+func (b *Bot) EditMessageText(options EditMessageTextOptions) (types.EditedMessage, error) {
+	if err := options.validate(); err != nil {
+		return types.EditedMessage{}, err
+	}
 
-		return msgResult.Result, nil
+	if err := utils.ValidateStruct(options); err != nil {
+		return types.EditedMessage{}, err
 	}
 
+	return request[types.EditedMessage](b, editMessageTextUrl, options)
 }
 
 type EditMessageCaptionOptions struct {
@@ -137,18 +160,8 @@ type EditMessageCaptionOptions struct {
 	// behalf of which the message to be edited was sent
 	BusinessConnectionID string `json:"business_connection_id,omitempty"`
 
-	// Required if inline_message_id is not specified.
-	// Unique identifier for the target chat or username
-	// of the target channel (in the format @channelusername)
-	ChatID string `json:"chat_id,omitempty"`
-
-	// Required if inline_message_id is not specified.
-	// Identifier of the message to edit
-	MessageID int `json:"message_id,omitempty"`
-
-	// Required if chat_id and message_id are not specified.
-	// Identifier of the inline message
-	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// The message to edit
+	MessageTarget
 
 	// New caption of the message, 0-1024
 	// characters after entities parsing
@@ -156,7 +169,7 @@ type EditMessageCaptionOptions struct {
 
 	// Mode for parsing entities in the message caption.
 	// See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode types.ParseMode `json:"parse_mode,omitempty"`
 
 	// A JSON-serialized list of special entities that appear in
 	// the caption, which can be specified instead of parse_mode
@@ -177,24 +190,202 @@ type EditMessageCaptionOptions struct {
 // messages that were not sent by the bot and do
 // not contain an inline keyboard can only be edited
 // within 48 hours from the time they were sent.
-func (b *Bot) EditMessageCaption(options EditMessageCaptionOptions) (bool, error) {
-	if options.InlineMessageID != "" {
-		if options.ChatID != "" || options.MessageID != 0 {
-			return false, fmt.Errorf(
-				"ChatID and MessageID should not be specified for inline messages",
-			)
-		}
+func (b *Bot) EditMessageCaption(options EditMessageCaptionOptions) (types.EditedMessage, error) {
+	if err := options.validate(); err != nil {
+		return types.EditedMessage{}, err
 	}
 
-	if options.ChatID == "" {
-		return false, fmt.Errorf("ChatID is required for non-inline messages")
+	return request[types.EditedMessage](b, editMessageCaptionUrl, options)
+}
+
+type EditMessageMediaOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message to be edited was sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// The message to edit
+	MessageTarget
+
+	// A JSON-serialized object for a new media content of the message
+	Media types.InputMedia `json:"media"`
+
+	// A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *types.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Use this method to edit animation, audio, document, photo, or video
+// messages, or to add media to text messages. If a message is part of
+// a message album, then it can be edited only to an audio for audio
+// albums, only to a document for document albums and to a photo or a
+// video otherwise. When an inline message is edited, a new file can't
+// be uploaded; use a previously uploaded file via its file_id or
+// specify a URL. On success, if the edited message is not an inline
+// message, the edited Message is returned, otherwise True is returned.
+// Note that business messages that were not sent by the bot and do not
+// contain an inline keyboard can only be edited within 48 hours from
+// the time they were sent.
+func (b *Bot) EditMessageMedia(options EditMessageMediaOptions) (types.EditedMessage, error) {
+	if err := options.validate(); err != nil {
+		return types.EditedMessage{}, err
+	}
+
+	if options.Media.IsZero() {
+		return types.EditedMessage{}, errors.New("media is required")
+	}
+
+	return request[types.EditedMessage](b, editMessageMediaUrl, options)
+}
+
+type EditMessageLiveLocationOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message to be edited was sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// The message to edit
+	MessageTarget
+
+	// Latitude of new location
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of new location
+	Longitude float64 `json:"longitude"`
+
+	// New period in seconds during which the location can be updated,
+	// starting from the message send date. If 0x7FFFFFFF is specified,
+	// then the location can be updated forever. Otherwise, the new value
+	// must not exceed the current live_period by more than a day, and the
+	// live location expiration date must remain within the next 90 days.
+	// If not specified, then live_period remains unchanged
+	LivePeriod int `json:"live_period,omitempty"`
+
+	// The radius of uncertainty for the location,
+	// measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+
+	// Direction in which the user is moving, in degrees.
+	// Must be between 1 and 360 if specified.
+	Heading int `json:"heading,omitempty"`
+
+	// The maximum distance for proximity alerts about
+	// approaching another chat member, in meters.
+	// Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+
+	// A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *types.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Use this method to edit live location messages. A location can be
+// edited until its live_period expires or editing is explicitly
+// disabled by a call to stopMessageLiveLocation. On success, if the
+// edited message is not an inline message, the edited Message is
+// returned, otherwise True is returned.
+func (b *Bot) EditMessageLiveLocation(options EditMessageLiveLocationOptions) (types.EditedMessage, error) {
+	if err := options.validate(); err != nil {
+		return types.EditedMessage{}, err
+	}
+
+	return request[types.EditedMessage](b, editMessageLiveLocationUrl, options)
+}
+
+type StopMessageLiveLocationOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message to be edited was sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// The message with live location to stop
+	MessageTarget
+
+	// A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *types.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Use this method to stop updating a live location message before
+// live_period expires. On success, if the message is not an inline
+// message, the edited Message is returned, otherwise True is returned.
+func (b *Bot) StopMessageLiveLocation(options StopMessageLiveLocationOptions) (types.EditedMessage, error) {
+	if err := options.validate(); err != nil {
+		return types.EditedMessage{}, err
+	}
+
+	return request[types.EditedMessage](b, stopMessageLiveLocationUrl, options)
+}
+
+type EditMessageChecklistOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id" validate:"required"`
+
+	// The message to edit. Inline messages are not supported
+	MessageTarget
+
+	// A JSON-serialized object for the new checklist
+	Checklist types.InputChecklist `json:"checklist" validate:"required"`
+
+	// A JSON-serialized object for the new inline keyboard for the message
+	ReplyMarkup *types.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Use this method to edit a checklist on behalf of a connected
+// business account. On success, the edited Message is returned.
+func (b *Bot) EditMessageChecklist(options EditMessageChecklistOptions) (types.Message, error) {
+	if err := options.validateChatMessage(); err != nil {
+		return types.Message{}, err
+	}
+
+	if err := utils.ValidateStruct(options); err != nil {
+		return types.Message{}, err
+	}
+
+	return request[types.Message](b, editMessageChecklistUrl, options)
+}
+
+type EditMessageReplyMarkupOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message to be edited was sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// The message to edit
+	MessageTarget
+
+	// A JSON-serialized object for an inline keyboard.
+	// Leave empty to remove the keyboard
+	ReplyMarkup *types.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Use this method to edit only the reply markup of messages. On success,
+// if the edited message is not an inline message, the edited Message is
+// returned, otherwise True is returned. Note that business messages that
+// were not sent by the bot and do not contain an inline keyboard can only
+// be edited within 48 hours from the time they were sent.
+func (b *Bot) EditMessageReplyMarkup(options EditMessageReplyMarkupOptions) (types.EditedMessage, error) {
+	if err := options.validate(); err != nil {
+		return types.EditedMessage{}, err
 	}
 
-	if options.MessageID == 0 {
-		return false, fmt.Errorf("MessageID is required for non-inline messages")
+	return request[types.EditedMessage](b, editMessageReplyMarkupUrl, options)
+}
+
+type StopPollOptions struct {
+	// Unique identifier of the business connection on
+	// behalf of which the message to be edited was sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+
+	// The message with the poll to stop. Inline messages are not supported
+	MessageTarget
+
+	// A JSON-serialized object for a new message inline keyboard.
+	ReplyMarkup *types.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Use this method to stop a poll which was sent by the bot.
+// On success, the stopped Poll is returned.
+func (b *Bot) StopPoll(options StopPollOptions) (types.Poll, error) {
+	if err := options.validateChatMessage(); err != nil {
+		return types.Poll{}, err
 	}
 
-	return true, nil
+	return request[types.Poll](b, stopPollUrl, options)
 }
 
 // Please note, that it is currently only possible to edit
@@ -229,7 +420,7 @@ func (b *Bot) EditMessageCaption(options EditMessageCaptionOptions) (bool, error
 // channel, it can delete any message in the corresponding direct messages chat.
 //
 // Returns True on success.
-func (b *Bot) DeleteMessage(chatID, messageID uint) error {
+func (b *Bot) DeleteMessage(chatID types.ChatID, messageID int) error {
 	data := map[string]any{
 		"chat_id":    chatID,
 		"message_id": messageID,
@@ -238,7 +429,7 @@ func (b *Bot) DeleteMessage(chatID, messageID uint) error {
 	return b.deleteMsgs(data, b.urlWithToken+deleteMessageUrl)
 }
 
-func (b *Bot) DeleteMessages(chatID uint, messageIDs []uint) error {
+func (b *Bot) DeleteMessages(chatID types.ChatID, messageIDs []int) error {
 	data := map[string]any{
 		"chat_id":     chatID,
 		"message_ids": messageIDs,
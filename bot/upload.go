@@ -0,0 +1,83 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/purkhanov/gogram/api"
+)
+
+const (
+	// The maximum size of a file uploaded with multipart/form-data
+	maxUploadSize = 10 << 20 // 10MB
+
+	// Uploads take longer than other requests,
+	// so they get a timeout of their own
+	uploadRequestTimeout = 60 * time.Second
+)
+
+// uploadFile is a file sent in a multipart/form-data request.
+type uploadFile struct {
+	// Name of the form field
+	field string
+
+	// Name of the file sent to Telegram
+	name string
+
+	// Contents of the file
+	reader io.Reader
+}
+
+// requestWithFile calls the given API method with a multipart/form-data
+// body made of the fields and the file, and decodes the result.
+func requestWithFile[T api.ResponseType](
+	b *Bot, methodUrl string, fields map[string]string, file uploadFile,
+) (T, error) {
+	var zero T
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return zero, fmt.Errorf("failed to write %s field: %w", key, err)
+		}
+	}
+
+	part, err := writer.CreateFormFile(file.field, file.name)
+	if err != nil {
+		return zero, fmt.Errorf("failed to create form file: %w", err)
+	}
+
+	// One byte over the limit is enough to tell the file is too large
+	n, err := io.Copy(part, io.LimitReader(file.reader, maxUploadSize+1))
+	if err != nil {
+		return zero, fmt.Errorf("failed to copy file: %w", err)
+	}
+
+	if n > maxUploadSize {
+		return zero, fmt.Errorf("file too large: over %d bytes", maxUploadSize)
+	}
+
+	if err := writer.Close(); err != nil {
+		return zero, fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+
+	c, cancel := context.WithTimeout(b.ctx, uploadRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(
+		c, http.MethodPost, b.urlWithToken+methodUrl, body,
+	)
+	if err != nil {
+		return zero, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return decodeResponse[T](b.api.DoRequest(req))
+}
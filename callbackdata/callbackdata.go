@@ -0,0 +1,295 @@
+// Package callbackdata packs typed structs into the callback_data
+// of inline keyboard buttons and unpacks them back in handlers.
+//
+// A struct is encoded as its prefix followed by the values of its
+// exported fields in declaration order, separated by colons:
+//
+//	type Order struct {
+//		Action string
+//		ID     int
+//	}
+//
+//	orders := callbackdata.New[Order]("order")
+//	data, _ := orders.Pack(Order{Action: "buy", ID: 42}) // "order:buy:42"
+//
+// Supported field kinds are strings, booleans, integers and floats.
+// Fields tagged with `cb:"-"` are skipped.
+package callbackdata
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	filters "github.com/purkhanov/gogram/filter"
+	"github.com/purkhanov/gogram/types"
+)
+
+const (
+	// MaxSize is the maximum size of callback data in bytes.
+	MaxSize = 64
+
+	separator    = ":"
+	storedMarker = "#"
+	storedKeyLen = 16
+)
+
+var ErrTooLong = errors.New("callback data exceeds 64 bytes")
+
+type CallbackData[T any] struct {
+	prefix  string
+	storage Storage
+}
+
+// New returns a factory for callback data with the given prefix.
+// It panics if T is not a struct or the prefix is empty, contains
+// the separator or is too long for the key of stored data to fit
+// into MaxSize, since these are programming errors.
+func New[T any](prefix string) *CallbackData[T] {
+	if prefix == "" || strings.ContainsAny(prefix, separator+storedMarker) {
+		panic(fmt.Sprintf("callbackdata: invalid prefix %q", prefix))
+	}
+
+	if n := len(prefix + separator + storedMarker) + storedKeyLen; n > MaxSize {
+		panic(fmt.Sprintf("callbackdata: prefix %q is too long, stored data takes %d bytes", prefix, n))
+	}
+
+	var zero T
+	if reflect.TypeOf(zero).Kind() != reflect.Struct {
+		panic(fmt.Sprintf("callbackdata: %T is not a struct", zero))
+	}
+
+	return &CallbackData[T]{prefix: prefix}
+}
+
+// WithStorage enables keeping payloads that don't fit into 64 bytes
+// on the server side. Only a short key is sent to Telegram then.
+func (c *CallbackData[T]) WithStorage(storage Storage) *CallbackData[T] {
+	c.storage = storage
+	return c
+}
+
+func (c *CallbackData[T]) Prefix() string {
+	return c.prefix
+}
+
+// Pack encodes the value into a string suitable for
+// InlineKeyboardButton.CallbackData.
+func (c *CallbackData[T]) Pack(value T) (string, error) {
+	parts := []string{c.prefix}
+
+	v := reflect.ValueOf(value)
+	for _, i := range packedFields(v.Type()) {
+		part, err := encodeValue(v.Field(i))
+		if err != nil {
+			return "", fmt.Errorf("field %s: %w", v.Type().Field(i).Name, err)
+		}
+
+		parts = append(parts, part)
+	}
+
+	data := strings.Join(parts, separator)
+	if len(data) <= MaxSize {
+		return data, nil
+	}
+
+	if c.storage == nil {
+		return "", fmt.Errorf("%w: %d bytes", ErrTooLong, len(data))
+	}
+
+	key := storageKey(data)
+	if err := c.storage.Save(key, data); err != nil {
+		return "", fmt.Errorf("failed to store callback data: %w", err)
+	}
+
+	return c.prefix + separator + storedMarker + key, nil
+}
+
+// MustPack is like Pack but panics on error.
+func (c *CallbackData[T]) MustPack(value T) string {
+	data, err := c.Pack(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
+// Unpack decodes callback data produced by Pack.
+func (c *CallbackData[T]) Unpack(data string) (T, error) {
+	var result T
+
+	if !c.hasPrefix(data) {
+		return result, fmt.Errorf("callback data %q has no prefix %q", data, c.prefix)
+	}
+
+	rest := strings.TrimPrefix(data, c.prefix+separator)
+	if strings.HasPrefix(rest, storedMarker) {
+		if c.storage == nil {
+			return result, errors.New("callback data is stored but no storage is set")
+		}
+
+		stored, err := c.storage.Load(strings.TrimPrefix(rest, storedMarker))
+		if err != nil {
+			return result, fmt.Errorf("failed to load callback data: %w", err)
+		}
+
+		data = stored
+	}
+
+	parts := strings.Split(data, separator)[1:]
+
+	v := reflect.ValueOf(&result).Elem()
+	fields := packedFields(v.Type())
+
+	if len(parts) != len(fields) {
+		return result, fmt.Errorf(
+			"callback data has %d values, expected %d", len(parts), len(fields),
+		)
+	}
+
+	for n, i := range fields {
+		if err := decodeValue(v.Field(i), parts[n]); err != nil {
+			return result, fmt.Errorf("field %s: %w", v.Type().Field(i).Name, err)
+		}
+	}
+
+	return result, nil
+}
+
+// Filter passes callback queries with this prefix whose unpacked
+// value satisfies all predicates, e.g.
+//
+//	orders.Filter(func(o Order) bool { return o.Action == "buy" })
+func (c *CallbackData[T]) Filter(predicates ...func(T) bool) filters.CallbackFilter {
+	return func(cb *types.CallbackQuery) bool {
+		if !c.hasPrefix(cb.Data) {
+			return false
+		}
+
+		value, err := c.Unpack(cb.Data)
+		if err != nil {
+			return false
+		}
+
+		for _, predicate := range predicates {
+			if !predicate(value) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Handler adapts a typed handler for Dispatcher.OnCallbackQuery.
+// Queries whose data can't be unpacked are ignored.
+func (c *CallbackData[T]) Handler(
+	handler func(*types.CallbackQuery, T),
+) func(*types.CallbackQuery) {
+	return func(cb *types.CallbackQuery) {
+		value, err := c.Unpack(cb.Data)
+		if err != nil {
+			return
+		}
+
+		handler(cb, value)
+	}
+}
+
+func (c *CallbackData[T]) hasPrefix(data string) bool {
+	return data == c.prefix || strings.HasPrefix(data, c.prefix+separator)
+}
+
+func packedFields(t reflect.Type) []int {
+	var fields []int
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Tag.Get("cb") == "-" {
+			continue
+		}
+
+		fields = append(fields, i)
+	}
+
+	return fields
+}
+
+var escaper = strings.NewReplacer("%", "%25", separator, "%3A", storedMarker, "%23")
+var unescaper = strings.NewReplacer("%3A", separator, "%23", storedMarker, "%25", "%")
+
+func encodeValue(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return escaper.Replace(v.String()), nil
+
+	case reflect.Bool:
+		if v.Bool() {
+			return "1", nil
+		}
+		return "0", nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+
+	default:
+		return "", fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+}
+
+func decodeValue(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(unescaper.Replace(s))
+
+	case reflect.Bool:
+		switch s {
+		case "0", "1":
+			v.SetBool(s == "1")
+		default:
+			return fmt.Errorf("invalid boolean %q", s)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+
+	default:
+		return fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+
+	return nil
+}
+
+func storageKey(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(sum[:])[:storedKeyLen]
+}
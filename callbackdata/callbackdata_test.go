@@ -0,0 +1,47 @@
+package callbackdata
+
+import (
+	"strings"
+	"testing"
+)
+
+type flag struct {
+	On bool
+}
+
+func TestNewLongPrefix(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("New didn't panic on a prefix too long for stored data")
+		}
+	}()
+
+	New[flag](strings.Repeat("p", MaxSize))
+}
+
+func TestUnpackBool(t *testing.T) {
+	flags := New[flag]("f")
+
+	tests := []struct {
+		data    string
+		want    bool
+		wantErr bool
+	}{
+		{"f:1", true, false},
+		{"f:0", false, false},
+		{"f:true", false, true},
+		{"f:", false, true},
+	}
+
+	for _, tt := range tests {
+		got, err := flags.Unpack(tt.data)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Unpack(%q) error = %v, want error %t", tt.data, err, tt.wantErr)
+			continue
+		}
+
+		if got.On != tt.want {
+			t.Errorf("Unpack(%q) = %t, want %t", tt.data, got.On, tt.want)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package callbackdata
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Storage keeps callback payloads that are too long for Telegram.
+type Storage interface {
+	Save(key, data string) error
+	Load(key string) (string, error)
+}
+
+// MemoryStorage is a Storage that keeps payloads in memory.
+// Stored payloads are lost when the process restarts.
+type MemoryStorage struct {
+	mu   sync.RWMutex
+	data map[string]string
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{data: make(map[string]string)}
+}
+
+func (s *MemoryStorage) Save(key, data string) error {
+	s.mu.Lock()
+	s.data[key] = data
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *MemoryStorage) Load(key string) (string, error) {
+	s.mu.RLock()
+	data, ok := s.data[key]
+	s.mu.RUnlock()
+
+	if !ok {
+		return "", fmt.Errorf("callback data %q not found", key)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,505 @@
+// Package captcha verifies new chat members. When a user joins a
+// group, they are muted and asked to solve a challenge with inline
+// buttons. Solving it lifts the restrictions, a wrong answer or the
+// timeout kicks the user and deletes the challenge messages. Users
+// that send a join request solve the challenge in the private chat
+// with the bot, and the request is approved or declined.
+//
+//	c := captcha.New(d, captcha.Config{Kind: captcha.KindMath})
+//	c.SetChatConfig(rulesChatID, captcha.Config{Kind: captcha.KindEmoji})
+package captcha
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/purkhanov/gogram/bot"
+	"github.com/purkhanov/gogram/callbackdata"
+	"github.com/purkhanov/gogram/dispatcher"
+	"github.com/purkhanov/gogram/format"
+	"github.com/purkhanov/gogram/keyboard"
+	"github.com/purkhanov/gogram/types"
+)
+
+const (
+	callbackPrefix    = "captcha"
+	defaultTimeout    = 2 * time.Minute
+	defaultAnswers    = 4
+	defaultButtonText = "I'm not a bot"
+
+	// Restrictions shorter than this are treated by Telegram as
+	// permanent, so ones about to expire are lifted instead
+	minRestrictionPeriod = 30 * time.Second
+
+	// The mute outlasts the timeout by this margin. Challenges are
+	// kept in memory only, so if the bot restarts, the mute still
+	// expires by itself instead of lasting forever.
+	muteMargin = time.Minute
+)
+
+type Config struct {
+	// Optional. Turns verification off for the chat
+	Disabled bool
+
+	// Optional. Type of the challenge. Defaults to KindButton.
+	Kind Kind
+
+	// Optional. Time to solve the challenge. Defaults to 2 minutes.
+	Timeout time.Duration
+
+	// Optional. Number of answer buttons for math and emoji
+	// challenges, at least 2. Defaults to 4.
+	Answers int
+
+	// Optional. Label of the KindButton button.
+	// Defaults to “I'm not a bot”.
+	ButtonText string
+
+	// Optional. Text of the challenge message.
+	// Defaults to a mention of the user followed by the question.
+	Text func(user types.User, question string, timeout time.Duration) format.Node
+
+	// Optional. Keeps the “user joined” service message
+	// of users that failed the challenge.
+	KeepJoinMessage bool
+
+	// Optional. Called after the user has passed the challenge.
+	OnPassed func(chatID int64, user types.User)
+
+	// Optional. Called after the user has failed the challenge.
+	OnFailed func(chatID int64, user types.User)
+}
+
+// botAPI is the part of *bot.Bot used by the captcha.
+type botAPI interface {
+	SendMessage(params bot.SendMessageOptions) (types.Message, error)
+	AnswerCallbackQuery(params bot.AnswerCallbackQueryOptions) error
+	DeleteMessage(chatID types.ChatID, messageID int) error
+	DeleteMessages(chatID types.ChatID, messageIDs []int) error
+	GetChatMember(chatID types.ChatID, userID int64) (types.ChatMember, error)
+	Mute(chatID types.ChatID, userID int64, duration time.Duration) error
+	Unmute(chatID types.ChatID, userID int64) error
+	RestrictChatMember(options bot.RestrictChatMemberOptions) error
+	Kick(chatID types.ChatID, userID int64) error
+	ApproveChatJoinRequest(chatID types.ChatID, userID int64) error
+	DeclineChatJoinRequest(chatID types.ChatID, userID int64) error
+}
+
+type Captcha struct {
+	bot    botAPI
+	config Config
+	data   *callbackdata.CallbackData[answerData]
+
+	mu      sync.Mutex
+	chats   map[int64]Config
+	pending map[pendingKey]*pending
+}
+
+type answerData struct {
+	ChatID int64
+	UserID int64
+	Answer int
+}
+
+type pendingKey struct {
+	chatID int64
+	userID int64
+}
+
+// pending is a challenge waiting for the answer of a user.
+type pending struct {
+	config    Config
+	user      types.User
+	challenge challenge
+	timer     *time.Timer
+
+	// The chat where the challenge message was sent:
+	// the group itself, or the private chat for join requests
+	messageChat types.ChatID
+	messageID   int
+
+	// Identifier of the “user joined” service message, 0 for join requests
+	joinMessageID int
+
+	// Restrictions the member had before joining, restored
+	// after the challenge; nil if the member had none
+	restricted *types.ChatMemberRestricted
+
+	joinRequest bool
+}
+
+// New creates a captcha with the default config for all chats
+// and registers its handlers in the dispatcher.
+func New(d *dispatcher.Dispatcher, config Config) *Captcha {
+	c := newCaptcha(d.Bot, config)
+
+	d.OnMessage(c.handleNewMembers, func(m *types.Message) bool {
+		return len(m.NewChatMembers) > 0
+	})
+	d.OnChatJoinRequest(c.handleJoinRequest)
+	d.OnCallbackQuery(c.data.Handler(c.handleAnswer), c.data.Filter())
+
+	return c
+}
+
+func newCaptcha(b botAPI, config Config) *Captcha {
+	return &Captcha{
+		bot:     b,
+		config:  config,
+		data:    callbackdata.New[answerData](callbackPrefix),
+		chats:   make(map[int64]Config),
+		pending: make(map[pendingKey]*pending),
+	}
+}
+
+// SetChatConfig overrides the default config for the chat.
+func (c *Captcha) SetChatConfig(chatID int64, config Config) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.chats[chatID] = config
+}
+
+func (c *Captcha) chatConfig(chatID int64) Config {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	config, ok := c.chats[chatID]
+	if !ok {
+		config = c.config
+	}
+
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeout
+	}
+
+	if config.Answers <= 0 {
+		config.Answers = defaultAnswers
+	}
+
+	if config.ButtonText == "" {
+		config.ButtonText = defaultButtonText
+	}
+
+	if config.Text == nil {
+		config.Text = defaultText
+	}
+
+	return config
+}
+
+func defaultText(user types.User, question string, timeout time.Duration) format.Node {
+	return format.Group(
+		format.Mention(user),
+		format.Textf(", to join the chat %s within %s.", question, humanDuration(timeout)),
+	)
+}
+
+// humanDuration formats d for users, e.g. “2 minutes” or “90 seconds”.
+func humanDuration(d time.Duration) string {
+	n, unit := int(d.Round(time.Second)/time.Second), "second"
+	if d >= time.Minute && d%time.Minute == 0 {
+		n, unit = int(d/time.Minute), "minute"
+	}
+
+	if n != 1 {
+		unit += "s"
+	}
+
+	return fmt.Sprintf("%d %s", n, unit)
+}
+
+// handleNewMembers challenges users that joined the group themselves.
+// Bots and users added by other members are trusted.
+func (c *Captcha) handleNewMembers(_ context.Context, m *types.Message) {
+	config := c.chatConfig(m.Chat.ID)
+	if config.Disabled {
+		return
+	}
+
+	for _, user := range m.NewChatMembers {
+		if user.IsBot || m.From == nil || m.From.ID != user.ID {
+			continue
+		}
+
+		restricted := c.restrictions(m.Chat.ID, user.ID)
+
+		err := c.bot.Mute(m.Chat.ChatID(), user.ID, config.Timeout+muteMargin)
+		if err != nil {
+			log.Printf("captcha: failed to restrict user %d: %v", user.ID, err)
+			continue
+		}
+
+		c.challenge(m.Chat.ID, user, config, &pending{
+			messageChat:   m.Chat.ChatID(),
+			joinMessageID: m.MessageID,
+			restricted:    restricted,
+		})
+	}
+}
+
+// restrictions returns the restrictions placed on the member by
+// administrators. A member challenged again keeps the restrictions
+// saved by the first challenge, since the current ones are the mute.
+func (c *Captcha) restrictions(chatID, userID int64) *types.ChatMemberRestricted {
+	c.mu.Lock()
+	p, ok := c.pending[pendingKey{chatID: chatID, userID: userID}]
+	c.mu.Unlock()
+
+	if ok && !p.joinRequest {
+		return p.restricted
+	}
+
+	member, err := c.bot.GetChatMember(types.NewChatID(chatID), userID)
+	if err != nil {
+		log.Printf("captcha: failed to get restrictions of user %d: %v", userID, err)
+		return nil
+	}
+
+	return member.Restricted
+}
+
+// liftRestrictions removes the mute of the challenge and restores
+// the restrictions the member had before, unless they have expired.
+func (c *Captcha) liftRestrictions(key pendingKey, p *pending) error {
+	chatID := types.NewChatID(key.chatID)
+
+	r := p.restricted
+	if r == nil {
+		return c.bot.Unmute(chatID, key.userID)
+	}
+
+	until := r.Until()
+	if !until.IsZero() && time.Until(until) < minRestrictionPeriod {
+		return c.bot.Unmute(chatID, key.userID)
+	}
+
+	return c.bot.RestrictChatMember(bot.RestrictChatMemberOptions{
+		ChatID:                        chatID,
+		UserID:                        key.userID,
+		Permissions:                   r.ChatPermissions,
+		UseIndependentChatPermissions: true,
+		UntilDate:                     until,
+	})
+}
+
+// handleJoinRequest challenges the user in the private chat with the bot.
+func (c *Captcha) handleJoinRequest(r *types.ChatJoinRequest) {
+	config := c.chatConfig(r.Chat.ID)
+	if config.Disabled {
+		return
+	}
+
+	c.challenge(r.Chat.ID, r.From, config, &pending{
+		messageChat: types.NewChatID(r.UserChatID),
+		joinRequest: true,
+	})
+}
+
+func (c *Captcha) challenge(chatID int64, user types.User, config Config, p *pending) {
+	key := pendingKey{chatID: chatID, userID: user.ID}
+
+	p.config = config
+	p.user = user
+	p.challenge = newChallenge(config.Kind, config.ButtonText, config.Answers)
+
+	kb := keyboard.NewInline()
+	for i, answer := range p.challenge.answers {
+		kb.Callback(answer, c.data.MustPack(answerData{
+			ChatID: chatID,
+			UserID: user.ID,
+			Answer: i,
+		}))
+	}
+
+	markup, err := kb.Adjust(len(p.challenge.answers)).Build()
+	if err != nil {
+		log.Printf("captcha: failed to build keyboard: %v", err)
+		return
+	}
+
+	text, entities := format.Entities(config.Text(user, p.challenge.question, config.Timeout))
+
+	params := bot.SendMessageOptions{
+		ChatID:      p.messageChat,
+		Text:        text,
+		Entities:    entities,
+		ReplyMarkup: markup,
+	}
+
+	if p.joinMessageID != 0 {
+		params.ReplyParameters = &types.ReplyParameters{
+			MessageID:                p.joinMessageID,
+			AllowSendingWithoutReply: true,
+		}
+	}
+
+	// The challenge is registered before it's sent, since
+	// the answer may arrive before SendMessage returns
+	var previousMessageID int
+
+	c.mu.Lock()
+	previous, replaced := c.pending[key]
+	if replaced {
+		previous.timer.Stop()
+		previousMessageID = previous.messageID
+	}
+	p.timer = time.AfterFunc(config.Timeout, func() {
+		if c.take(key, p) {
+			c.finish(key, p, false)
+		}
+	})
+	c.pending[key] = p
+	c.mu.Unlock()
+
+	// The buttons of the replaced challenge would answer this one
+	if previousMessageID != 0 {
+		if err := c.bot.DeleteMessage(previous.messageChat, previousMessageID); err != nil {
+			log.Printf("captcha: failed to delete messages: %v", err)
+		}
+	}
+
+	msg, err := c.bot.SendMessage(params)
+	if err != nil {
+		log.Printf("captcha: failed to send challenge to user %d: %v", user.ID, err)
+
+		if !c.take(key, p) {
+			return
+		}
+		p.timer.Stop()
+
+		// The user can't be verified, so they are not kept muted.
+		// Join requests are left for administrators to process.
+		if !p.joinRequest {
+			if err := c.liftRestrictions(key, p); err != nil {
+				log.Printf("captcha: failed to lift restrictions from user %d: %v", user.ID, err)
+			}
+		}
+
+		return
+	}
+
+	c.mu.Lock()
+	finished := c.pending[key] != p
+	if !finished {
+		p.messageID = msg.MessageID
+	}
+	c.mu.Unlock()
+
+	// The challenge was finished or replaced before its
+	// message was known, so the message is deleted here
+	if finished {
+		if err := c.bot.DeleteMessage(p.messageChat, msg.MessageID); err != nil {
+			log.Printf("captcha: failed to delete messages: %v", err)
+		}
+	}
+}
+
+func (c *Captcha) handleAnswer(cb *types.CallbackQuery, data answerData) {
+	answer := bot.AnswerCallbackQueryOptions{CallbackQueryID: cb.ID}
+
+	defer func() {
+		if err := c.bot.AnswerCallbackQuery(answer); err != nil {
+			log.Printf("captcha: failed to answer callback query: %v", err)
+		}
+	}()
+
+	if cb.From.ID != data.UserID {
+		answer.Text = "This challenge is for another user."
+		answer.ShowAlert = true
+		return
+	}
+
+	key := pendingKey{chatID: data.ChatID, userID: data.UserID}
+
+	c.mu.Lock()
+	p, ok := c.pending[key]
+	c.mu.Unlock()
+
+	if !ok || !c.take(key, p) {
+		answer.Text = "The challenge has expired."
+		return
+	}
+
+	p.timer.Stop()
+
+	passed := data.Answer == p.challenge.correct
+	if !passed {
+		answer.Text = "Wrong answer."
+	}
+
+	c.finish(key, p, passed)
+}
+
+// take removes the challenge from the pending ones. It reports
+// false if the challenge has already been finished or replaced.
+func (c *Captcha) take(key pendingKey, p *pending) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.pending[key] != p {
+		return false
+	}
+
+	delete(c.pending, key)
+	return true
+}
+
+// finish applies the result of the challenge and cleans up its messages.
+func (c *Captcha) finish(key pendingKey, p *pending, passed bool) {
+	chatID := types.NewChatID(key.chatID)
+
+	var err error
+
+	switch {
+	case p.joinRequest && passed:
+		err = c.bot.ApproveChatJoinRequest(chatID, key.userID)
+	case p.joinRequest:
+		err = c.bot.DeclineChatJoinRequest(chatID, key.userID)
+	case passed:
+		err = c.liftRestrictions(key, p)
+	default:
+		err = c.bot.Kick(chatID, key.userID)
+	}
+
+	if err != nil {
+		log.Printf("captcha: failed to apply result for user %d: %v", key.userID, err)
+	}
+
+	c.mu.Lock()
+	messageID := p.messageID
+	c.mu.Unlock()
+
+	var messageIDs []int
+	if messageID != 0 {
+		messageIDs = append(messageIDs, messageID)
+	}
+
+	if !passed && !p.config.KeepJoinMessage && p.joinMessageID != 0 {
+		messageIDs = append(messageIDs, p.joinMessageID)
+	}
+
+	if len(messageIDs) > 0 {
+		if err := c.bot.DeleteMessages(p.messageChat, messageIDs); err != nil {
+			log.Printf("captcha: failed to delete messages: %v", err)
+		}
+	}
+
+	switch {
+	case passed && p.config.OnPassed != nil:
+		p.config.OnPassed(key.chatID, p.user)
+	case !passed && p.config.OnFailed != nil:
+		p.config.OnFailed(key.chatID, p.user)
+	}
+}
+
+// Pending returns the number of users that haven't solved their challenge yet.
+func (c *Captcha) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.pending)
+}
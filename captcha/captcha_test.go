@@ -0,0 +1,278 @@
+package captcha
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/purkhanov/gogram/bot"
+	"github.com/purkhanov/gogram/types"
+)
+
+const (
+	testChatID = -100
+	testUserID = 42
+)
+
+// fakeBot records the calls made by the captcha.
+type fakeBot struct {
+	mu        sync.Mutex
+	calls     []string
+	answers   []string
+	messageID int
+}
+
+func (f *fakeBot) record(format string, args ...any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeBot) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.calls)
+}
+
+func (f *fakeBot) Answers() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.answers)
+}
+
+func (f *fakeBot) SendMessage(params bot.SendMessageOptions) (types.Message, error) {
+	f.mu.Lock()
+	f.messageID++
+	id := f.messageID
+	f.mu.Unlock()
+
+	f.record("send %d", id)
+	return types.Message{MessageID: id}, nil
+}
+
+func (f *fakeBot) AnswerCallbackQuery(params bot.AnswerCallbackQueryOptions) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.answers = append(f.answers, params.Text)
+	return nil
+}
+
+func (f *fakeBot) DeleteMessage(chatID types.ChatID, messageID int) error {
+	f.record("delete %d", messageID)
+	return nil
+}
+
+func (f *fakeBot) DeleteMessages(chatID types.ChatID, messageIDs []int) error {
+	f.record("delete %v", messageIDs)
+	return nil
+}
+
+func (f *fakeBot) GetChatMember(chatID types.ChatID, userID int64) (types.ChatMember, error) {
+	return types.ChatMember{Member: &types.ChatMemberMember{}}, nil
+}
+
+func (f *fakeBot) Mute(chatID types.ChatID, userID int64, duration time.Duration) error {
+	f.record("mute %s", duration)
+	return nil
+}
+
+func (f *fakeBot) Unmute(chatID types.ChatID, userID int64) error {
+	f.record("unmute")
+	return nil
+}
+
+func (f *fakeBot) RestrictChatMember(options bot.RestrictChatMemberOptions) error {
+	f.record("restrict")
+	return nil
+}
+
+func (f *fakeBot) Kick(chatID types.ChatID, userID int64) error {
+	f.record("kick")
+	return nil
+}
+
+func (f *fakeBot) ApproveChatJoinRequest(chatID types.ChatID, userID int64) error {
+	f.record("approve")
+	return nil
+}
+
+func (f *fakeBot) DeclineChatJoinRequest(chatID types.ChatID, userID int64) error {
+	f.record("decline")
+	return nil
+}
+
+func newTestCaptcha(config Config) (*Captcha, *fakeBot) {
+	f := &fakeBot{}
+	return newCaptcha(f, config), f
+}
+
+// join sends the service message of the user joining the group.
+func join(c *Captcha, joinMessageID int) {
+	user := types.User{ID: testUserID, FirstName: "Test"}
+
+	c.handleNewMembers(context.Background(), &types.Message{
+		MessageID:      joinMessageID,
+		From:           &user,
+		Chat:           &types.Chat{ID: testChatID},
+		NewChatMembers: []types.User{user},
+	})
+}
+
+// answer presses the button of the pending challenge, the correct one or not.
+func answer(t *testing.T, c *Captcha, correct bool) {
+	t.Helper()
+
+	key := pendingKey{chatID: testChatID, userID: testUserID}
+
+	c.mu.Lock()
+	p, ok := c.pending[key]
+	c.mu.Unlock()
+
+	index := -1
+	if ok {
+		index = p.challenge.correct
+		if !correct {
+			index = (index + 1) % len(p.challenge.answers)
+		}
+	}
+
+	c.handleAnswer(
+		&types.CallbackQuery{ID: "1", From: &types.User{ID: testUserID}},
+		answerData{ChatID: testChatID, UserID: testUserID, Answer: index},
+	)
+}
+
+func TestCaptcha(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Config
+		run         func(t *testing.T, c *Captcha)
+		wantCalls   []string
+		wantAnswers []string
+
+		// Results of finished challenges, true if passed
+		wantResults []bool
+	}{
+		{
+			name:   "timeout",
+			config: Config{Timeout: 10 * time.Millisecond},
+			run: func(t *testing.T, c *Captcha) {
+				join(c, 10)
+			},
+			wantCalls:   []string{"mute 1m0.01s", "send 1", "kick", "delete [1 10]"},
+			wantResults: []bool{false},
+		},
+		{
+			name:   "correct answer",
+			config: Config{Kind: KindMath},
+			run: func(t *testing.T, c *Captcha) {
+				join(c, 10)
+				answer(t, c, true)
+			},
+			wantCalls:   []string{"mute 3m0s", "send 1", "unmute", "delete [1]"},
+			wantAnswers: []string{""},
+			wantResults: []bool{true},
+		},
+		{
+			name:   "wrong answer",
+			config: Config{Kind: KindMath},
+			run: func(t *testing.T, c *Captcha) {
+				join(c, 10)
+				answer(t, c, false)
+			},
+			wantCalls:   []string{"mute 3m0s", "send 1", "kick", "delete [1 10]"},
+			wantAnswers: []string{"Wrong answer."},
+			wantResults: []bool{false},
+		},
+		{
+			name:   "double answer",
+			config: Config{Kind: KindMath},
+			run: func(t *testing.T, c *Captcha) {
+				join(c, 10)
+				answer(t, c, true)
+				answer(t, c, true)
+			},
+			wantCalls:   []string{"mute 3m0s", "send 1", "unmute", "delete [1]"},
+			wantAnswers: []string{"", "The challenge has expired."},
+			wantResults: []bool{true},
+		},
+		{
+			name:   "rejoin",
+			config: Config{Kind: KindMath, Timeout: 50 * time.Millisecond},
+			run: func(t *testing.T, c *Captcha) {
+				join(c, 10)
+				join(c, 11)
+				answer(t, c, true)
+
+				// The timer of the replaced challenge must not fire
+				time.Sleep(100 * time.Millisecond)
+			},
+			wantCalls: []string{
+				"mute 1m0.05s", "send 1",
+				"mute 1m0.05s", "delete 1", "send 2",
+				"unmute", "delete [2]",
+			},
+			wantAnswers: []string{""},
+			wantResults: []bool{true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := make(chan bool, 10)
+			tt.config.OnPassed = func(int64, types.User) { results <- true }
+			tt.config.OnFailed = func(int64, types.User) { results <- false }
+
+			c, f := newTestCaptcha(tt.config)
+			tt.run(t, c)
+
+			var got []bool
+			for range tt.wantResults {
+				select {
+				case result := <-results:
+					got = append(got, result)
+				case <-time.After(time.Second):
+					t.Fatal("challenge wasn't finished")
+				}
+			}
+
+			if len(results) > 0 || !slices.Equal(got, tt.wantResults) {
+				t.Errorf("results = %v and %d more, want %v", got, len(results), tt.wantResults)
+			}
+
+			if got := f.Calls(); !slices.Equal(got, tt.wantCalls) {
+				t.Errorf("calls = %q, want %q", got, tt.wantCalls)
+			}
+
+			if got := f.Answers(); !slices.Equal(got, tt.wantAnswers) {
+				t.Errorf("answers = %q, want %q", got, tt.wantAnswers)
+			}
+
+			if n := c.Pending(); n != 0 {
+				t.Errorf("%d challenges left pending", n)
+			}
+		})
+	}
+}
+
+func TestHumanDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		time.Minute:      "1 minute",
+		2 * time.Minute:  "2 minutes",
+		90 * time.Second: "90 seconds",
+		time.Second:      "1 second",
+	}
+
+	for d, want := range tests {
+		if got := humanDuration(d); got != want {
+			t.Errorf("humanDuration(%s) = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package captcha
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+)
+
+// Kind is the type of the challenge a new member has to solve.
+type Kind int
+
+const (
+	// A single button to press
+	KindButton Kind = iota
+
+	// A sum of two numbers to pick among several answers
+	KindMath
+
+	// A named emoji to pick among several emoji
+	KindEmoji
+)
+
+type emoji struct {
+	symbol string
+	name   string
+}
+
+var emojis = []emoji{
+	{"🍎", "apple"}, {"🍌", "banana"}, {"🍇", "grapes"}, {"🍒", "cherries"},
+	{"🐶", "dog"}, {"🐱", "cat"}, {"🐸", "frog"}, {"🐟", "fish"},
+	{"🚗", "car"}, {"✈️", "airplane"}, {"🚲", "bicycle"}, {"⚽", "ball"},
+	{"🌵", "cactus"}, {"🌙", "moon"}, {"⭐", "star"}, {"🔑", "key"},
+}
+
+// challenge is a question with answer buttons.
+type challenge struct {
+	question string
+	answers  []string
+	correct  int
+}
+
+// newChallenge makes a challenge of the kind with the given number
+// of answers, the correct one placed at a random position.
+func newChallenge(kind Kind, buttonText string, answers int) challenge {
+	switch kind {
+	case KindMath:
+		return mathChallenge(answers)
+	case KindEmoji:
+		return emojiChallenge(answers)
+	default:
+		return challenge{
+			question: "press the button below",
+			answers:  []string{buttonText},
+		}
+	}
+}
+
+func mathChallenge(answers int) challenge {
+	a, b := rand.IntN(9)+1, rand.IntN(9)+1
+	sum := a + b
+
+	// Wrong answers are taken from the sums of two digits
+	// around the correct one, so they look equally plausible.
+	candidates := make([]string, 0, 16)
+	for n := max(2, sum-8); n <= min(18, sum+8); n++ {
+		if n != sum {
+			candidates = append(candidates, strconv.Itoa(n))
+		}
+	}
+
+	return pick(fmt.Sprintf("how much is %d + %d?", a, b), strconv.Itoa(sum), candidates, answers)
+}
+
+func emojiChallenge(answers int) challenge {
+	order := rand.Perm(len(emojis))
+	target := emojis[order[0]]
+
+	candidates := make([]string, 0, len(order)-1)
+	for _, i := range order[1:] {
+		candidates = append(candidates, emojis[i].symbol)
+	}
+
+	return pick("press the "+target.name, target.symbol, candidates, answers)
+}
+
+// pick combines the correct answer with random wrong candidates.
+func pick(question, correct string, candidates []string, answers int) challenge {
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	wrong := candidates[:min(len(candidates), max(answers, 2)-1)]
+	position := rand.IntN(len(wrong) + 1)
+
+	c := challenge{question: question, correct: position}
+	c.answers = append(c.answers, wrong[:position]...)
+	c.answers = append(c.answers, correct)
+	c.answers = append(c.answers, wrong[position:]...)
+
+	return c
+}
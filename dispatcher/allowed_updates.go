@@ -0,0 +1,57 @@
+package dispatcher
+
+// defaultUpdates are the update types Telegram sends when no
+// allowed_updates are specified. The list must be kept in sync with
+// the Bot API: a type missing here is not received by bots that
+// handle reactions.
+var defaultUpdates = []string{
+	"message",
+	"edited_message",
+	"channel_post",
+	"edited_channel_post",
+	"business_connection",
+	"business_message",
+	"edited_business_message",
+	"deleted_business_messages",
+	"inline_query",
+	"chosen_inline_result",
+	"callback_query",
+	"shipping_query",
+	"pre_checkout_query",
+	"purchased_paid_media",
+	"poll",
+	"poll_answer",
+	"my_chat_member",
+	"chat_join_request",
+	"chat_boost",
+	"removed_chat_boost",
+}
+
+// AllowedUpdates returns the update types the bot should receive.
+// Reaction updates are only sent when requested explicitly, so if
+// handlers for them are registered, it returns the default types
+// along with message_reaction and message_reaction_count. Otherwise
+// it returns nil, and Telegram keeps using the previous setting.
+// Polling requests them automatically, and so does
+// Dispatcher.SetWebhook for webhooks.
+func (d *Dispatcher) AllowedUpdates() []string {
+	if len(d.handlers.messageReactions) == 0 && len(d.handlers.messageReactionCounts) == 0 {
+		return nil
+	}
+
+	allowed := append([]string(nil), defaultUpdates...)
+
+	if len(d.handlers.messageReactions) > 0 {
+		allowed = append(allowed, "message_reaction")
+	}
+
+	if len(d.handlers.messageReactionCounts) > 0 {
+		allowed = append(allowed, "message_reaction_count")
+	}
+
+	return allowed
+}
+
+func isReactionUpdate(update string) bool {
+	return update == "message_reaction" || update == "message_reaction_count"
+}
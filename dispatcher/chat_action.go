@@ -0,0 +1,39 @@
+package dispatcher
+
+import (
+	"context"
+	"log"
+
+	"github.com/purkhanov/gogram/bot"
+	"github.com/purkhanov/gogram/types"
+)
+
+// WithChatAction wraps the handler to show the chat action in the
+// chat of the message while the handler runs. The action follows
+// the message into its forum topic and business connection.
+//
+//	d.OnMessage(d.WithChatAction(types.ChatActionTyping, askModel))
+func (d *Dispatcher) WithChatAction(action types.ChatAction, handler messageHandlerFunc) messageHandlerFunc {
+	return func(ctx context.Context, msg *types.Message) {
+		options := bot.SendChatActionOptions{
+			ChatID: msg.Chat.ChatID(),
+			Action: action,
+		}
+
+		if msg.IsTopicMessage {
+			options.MessageThreadID = msg.MessageThreadID
+		}
+
+		if msg.BusinessConnectionID != nil {
+			options.BusinessConnectionID = *msg.BusinessConnectionID
+		}
+
+		stop, err := d.Bot.KeepChatAction(ctx, options)
+		if err != nil {
+			log.Println("failed to send chat action:", err)
+		}
+		defer stop()
+
+		handler(ctx, msg)
+	}
+}
@@ -0,0 +1,44 @@
+package dispatcher
+
+import (
+	filters "github.com/purkhanov/gogram/filter"
+	"github.com/purkhanov/gogram/types"
+)
+
+type chatJoinRequestHandlerFunc func(*types.ChatJoinRequest)
+
+type chatJoinRequestHandler struct {
+	filters []filters.ChatJoinRequestFilter
+	handler chatJoinRequestHandlerFunc
+}
+
+// OnChatJoinRequest registers a handler for requests to join a chat.
+// The bot must have the can_invite_users administrator right in the
+// chat to receive them. Every handler whose filters match is called.
+func (d *Dispatcher) OnChatJoinRequest(
+	handler chatJoinRequestHandlerFunc, filters ...filters.ChatJoinRequestFilter,
+) {
+	d.handlers.chatJoinRequests = append(d.handlers.chatJoinRequests, chatJoinRequestHandler{
+		filters: filters,
+		handler: handler,
+	})
+}
+
+func (d *Dispatcher) handleChatJoinRequest(request *types.ChatJoinRequest) {
+	for _, handler := range d.handlers.chatJoinRequests {
+		matches := true
+
+		for _, filter := range handler.filters {
+			if !filter(request) {
+				matches = false
+				break
+			}
+		}
+
+		if !matches {
+			continue
+		}
+
+		handler.handler(request)
+	}
+}
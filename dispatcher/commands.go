@@ -0,0 +1,150 @@
+package dispatcher
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/purkhanov/gogram/bot"
+	"github.com/purkhanov/gogram/types"
+)
+
+// CommandOption describes a command registered with OnCommand
+// for the command list shown to users.
+type CommandOption func(*commandInfo)
+
+type commandInfo struct {
+	command types.Command
+
+	// Descriptions by language code, "" is the default one
+	descriptions map[string]string
+
+	scopes []types.BotCommandScope
+}
+
+// WithDescription sets the description of the command. Commands
+// without a description are handled, but not published.
+func WithDescription(description string) CommandOption {
+	return WithLanguageDescription("", description)
+}
+
+// WithLanguageDescription sets the description of the command
+// for users with the given two-letter ISO 639-1 language code.
+func WithLanguageDescription(languageCode, description string) CommandOption {
+	return func(c *commandInfo) {
+		c.descriptions[languageCode] = description
+	}
+}
+
+// WithScopes limits the users to whom the command is shown.
+// The command is published in the default scope if none is given.
+func WithScopes(scopes ...types.BotCommandScope) CommandOption {
+	return func(c *commandInfo) {
+		c.scopes = append(c.scopes, scopes...)
+	}
+}
+
+// PublishCommands sets the bot's command list from the commands
+// registered with a description, one list per scope and language.
+// Commands without a description in some language are listed with
+// their default description. It is called when the dispatcher starts.
+func (d *Dispatcher) PublishCommands() error {
+	type scopeCommands struct {
+		scope    types.BotCommandScope
+		commands []commandInfo
+	}
+
+	var scopes []*scopeCommands
+	byScope := make(map[string]*scopeCommands)
+
+	for _, command := range d.handlers.commands {
+		if len(command.descriptions) == 0 {
+			continue
+		}
+
+		commandScopes := command.scopes
+		if len(commandScopes) == 0 {
+			commandScopes = []types.BotCommandScope{{}}
+		}
+
+		for _, scope := range commandScopes {
+			key, err := json.Marshal(scope)
+			if err != nil {
+				return fmt.Errorf("failed to marshal command scope: %w", err)
+			}
+
+			sc, ok := byScope[string(key)]
+			if !ok {
+				sc = &scopeCommands{scope: scope}
+				byScope[string(key)] = sc
+				scopes = append(scopes, sc)
+			}
+
+			sc.commands = append(sc.commands, command)
+		}
+	}
+
+	for _, sc := range scopes {
+		for _, languageCode := range commandLanguages(sc.commands) {
+			var commands []types.BotCommand
+
+			for _, command := range sc.commands {
+				description, ok := command.descriptions[languageCode]
+				if !ok {
+					description, ok = command.descriptions[""]
+				}
+
+				if !ok {
+					continue
+				}
+
+				commands = append(commands, types.BotCommand{
+					Command:     command.command.Name(),
+					Description: description,
+				})
+			}
+
+			if len(commands) == 0 {
+				continue
+			}
+
+			err := d.Bot.SetMyCommands(bot.SetMyCommandsOptions{
+				Commands:     commands,
+				Scope:        &sc.scope,
+				LanguageCode: languageCode,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to publish commands: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// commandLanguages returns the language codes used in descriptions
+// of the commands, starting with the default one.
+func commandLanguages(commands []commandInfo) []string {
+	languages := []string{""}
+
+	for _, command := range commands {
+		for languageCode := range command.descriptions {
+			if !slices.Contains(languages, languageCode) {
+				languages = append(languages, languageCode)
+			}
+		}
+	}
+
+	slices.Sort(languages[1:])
+
+	return languages
+}
+
+// publishCommandsOnStart logs instead of failing, so that a bot
+// with a misconfigured command list still handles updates.
+func (d *Dispatcher) publishCommandsOnStart() {
+	if err := d.PublishCommands(); err != nil {
+		log.Printf("error publishing commands: %v", err)
+	}
+}
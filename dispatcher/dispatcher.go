@@ -35,10 +35,18 @@ type Dispatcher struct {
 }
 
 type handlers struct {
-	messages         []messageHandler
-	callbacks        []callbackQueryHandler
-	preCheckoutQuery preCheckoutQueryHandlerFunc
-	shippingQuery    shippingQueryHandlerFunc
+	messages              []messageHandler
+	commands              []commandInfo
+	callbacks             []callbackQueryHandler
+	chatJoinRequests      []chatJoinRequestHandler
+	inlineQueries         []inlineQueryHandler
+	chosenInlineResult    chosenInlineResultHandlerFunc
+	polls                 []pollHandler
+	pollAnswers           []pollAnswerHandler
+	messageReactions      []messageReactionHandler
+	messageReactionCounts []messageReactionCountHandler
+	preCheckoutQuery      preCheckoutQueryHandlerFunc
+	shippingQuery         shippingQueryHandlerFunc
 }
 
 func NewDispatcher(token string) *Dispatcher {
@@ -78,12 +86,33 @@ func (d *Dispatcher) checkUpdate(update types.Update) {
 	case update.CallbackQuery != nil:
 		d.handleCallbackQuery(update.CallbackQuery)
 
+	case update.MessageReaction != nil:
+		d.handleMessageReaction(update.MessageReaction)
+
+	case update.MessageReactionCount != nil:
+		d.handleMessageReactionCount(update.MessageReactionCount)
+
+	case update.InlineQuery != nil:
+		d.handleInlineQuery(update.InlineQuery)
+
+	case update.ChosenInlineResult != nil:
+		d.handleChosenInlineResult(update.ChosenInlineResult)
+
+	case update.Poll != nil:
+		d.handlePoll(update.Poll)
+
+	case update.PollAnswer != nil:
+		d.handlePollAnswer(update.PollAnswer)
+
 	case update.PreCheckoutQuery != nil:
 		d.handlePreCheckoutQuery(update.PreCheckoutQuery)
 
 	case update.ShippingQuery != nil:
 		d.handleShippingQuery(update.ShippingQuery)
 
+	case update.ChatJoinRequest != nil:
+		d.handleChatJoinRequest(update.ChatJoinRequest)
+
 	default:
 		log.Println("unknown update type", update)
 	}
@@ -0,0 +1,34 @@
+package dispatcher
+
+import (
+	filters "github.com/purkhanov/gogram/filter"
+)
+
+// OnForumTopicCreated registers a handler for service
+// messages about topics created in forum supergroups.
+func (d *Dispatcher) OnForumTopicCreated(handler messageHandlerFunc, extra ...filters.MessageFilter) {
+	d.OnMessage(handler, withFilter(filters.ForumTopicCreated, extra)...)
+}
+
+// OnForumTopicEdited registers a handler for service
+// messages about topics renamed or given a new icon.
+func (d *Dispatcher) OnForumTopicEdited(handler messageHandlerFunc, extra ...filters.MessageFilter) {
+	d.OnMessage(handler, withFilter(filters.ForumTopicEdited, extra)...)
+}
+
+// OnForumTopicClosed registers a handler for service
+// messages about closed topics.
+func (d *Dispatcher) OnForumTopicClosed(handler messageHandlerFunc, extra ...filters.MessageFilter) {
+	d.OnMessage(handler, withFilter(filters.ForumTopicClosed, extra)...)
+}
+
+// OnForumTopicReopened registers a handler for service
+// messages about reopened topics.
+func (d *Dispatcher) OnForumTopicReopened(handler messageHandlerFunc, extra ...filters.MessageFilter) {
+	d.OnMessage(handler, withFilter(filters.ForumTopicReopened, extra)...)
+}
+
+// withFilter puts the filter of the event before the other filters.
+func withFilter(event filters.MessageFilter, extra []filters.MessageFilter) []filters.MessageFilter {
+	return append([]filters.MessageFilter{event}, extra...)
+}
@@ -0,0 +1,59 @@
+package dispatcher
+
+import (
+	filters "github.com/purkhanov/gogram/filter"
+	"github.com/purkhanov/gogram/types"
+)
+
+type inlineQueryHandlerFunc func(*types.InlineQuery)
+
+type inlineQueryHandler struct {
+	filters []filters.InlineQueryFilter
+	handler inlineQueryHandlerFunc
+}
+
+type chosenInlineResultHandlerFunc func(*types.ChosenInlineResult)
+
+// OnInlineQuery registers a handler for inline queries. Inline mode
+// must be enabled for the bot via @BotFather. Only the first handler
+// whose filters match is called, since a query can be answered once.
+func (d *Dispatcher) OnInlineQuery(
+	handler inlineQueryHandlerFunc, filters ...filters.InlineQueryFilter,
+) {
+	d.handlers.inlineQueries = append(d.handlers.inlineQueries, inlineQueryHandler{
+		filters: filters,
+		handler: handler,
+	})
+}
+
+// OnChosenInlineResult registers a handler for inline results chosen
+// by users. Inline feedback must be enabled for the bot via @BotFather.
+func (d *Dispatcher) OnChosenInlineResult(handler chosenInlineResultHandlerFunc) {
+	d.handlers.chosenInlineResult = handler
+}
+
+func (d *Dispatcher) handleInlineQuery(query *types.InlineQuery) {
+	for _, handler := range d.handlers.inlineQueries {
+		matches := true
+
+		for _, filter := range handler.filters {
+			if !filter(query) {
+				matches = false
+				break
+			}
+		}
+
+		if !matches {
+			continue
+		}
+
+		handler.handler(query)
+		return
+	}
+}
+
+func (d *Dispatcher) handleChosenInlineResult(result *types.ChosenInlineResult) {
+	if d.handlers.chosenInlineResult != nil {
+		d.handlers.chosenInlineResult(result)
+	}
+}
@@ -14,8 +14,23 @@ type messageHandler struct {
 	handler messageHandlerFunc
 }
 
-func (d *Dispatcher) OnCommand(command types.Command, handler messageHandlerFunc) {
-	d.OnMessage(handler, filters.IsCommand(command))
+// OnCommand registers a handler for the command. The options
+// describe the command for the list published with PublishCommands.
+func (d *Dispatcher) OnCommand(
+	command types.Command, handler messageHandlerFunc, options ...CommandOption,
+) {
+	info := commandInfo{
+		command:      command,
+		descriptions: make(map[string]string),
+	}
+
+	for _, option := range options {
+		option(&info)
+	}
+
+	d.handlers.commands = append(d.handlers.commands, info)
+
+	d.OnMessage(handler, filters.IsCommandFor(d.Bot, command))
 }
 
 func (d *Dispatcher) OnMessage(handler messageHandlerFunc, filters ...filters.MessageFilter) {
@@ -0,0 +1,79 @@
+package dispatcher
+
+import (
+	filters "github.com/purkhanov/gogram/filter"
+	"github.com/purkhanov/gogram/types"
+)
+
+type pollHandlerFunc func(*types.Poll)
+
+type pollHandler struct {
+	filters []filters.PollFilter
+	handler pollHandlerFunc
+}
+
+type pollAnswerHandlerFunc func(*types.PollAnswer)
+
+type pollAnswerHandler struct {
+	filters []filters.PollAnswerFilter
+	handler pollAnswerHandlerFunc
+}
+
+// OnPoll registers a handler for new poll states. Bots receive only
+// updates about manually stopped polls and polls which are sent by
+// the bot. Every handler whose filters match is called.
+func (d *Dispatcher) OnPoll(handler pollHandlerFunc, filters ...filters.PollFilter) {
+	d.handlers.polls = append(d.handlers.polls, pollHandler{
+		filters: filters,
+		handler: handler,
+	})
+}
+
+// OnPollAnswer registers a handler for changed answers in
+// non-anonymous polls. Bots receive new votes only in polls that
+// were sent by the bot itself. Every handler whose filters match
+// is called.
+func (d *Dispatcher) OnPollAnswer(handler pollAnswerHandlerFunc, filters ...filters.PollAnswerFilter) {
+	d.handlers.pollAnswers = append(d.handlers.pollAnswers, pollAnswerHandler{
+		filters: filters,
+		handler: handler,
+	})
+}
+
+func (d *Dispatcher) handlePoll(poll *types.Poll) {
+	for _, handler := range d.handlers.polls {
+		matches := true
+
+		for _, filter := range handler.filters {
+			if !filter(poll) {
+				matches = false
+				break
+			}
+		}
+
+		if !matches {
+			continue
+		}
+
+		handler.handler(poll)
+	}
+}
+
+func (d *Dispatcher) handlePollAnswer(answer *types.PollAnswer) {
+	for _, handler := range d.handlers.pollAnswers {
+		matches := true
+
+		for _, filter := range handler.filters {
+			if !filter(answer) {
+				matches = false
+				break
+			}
+		}
+
+		if !matches {
+			continue
+		}
+
+		handler.handler(answer)
+	}
+}
@@ -13,6 +13,10 @@ func (d *Dispatcher) StartPolling(skipUpdates bool) error {
 		return err
 	}
 
+	d.publishCommandsOnStart()
+
+	allowedUpdates := d.AllowedUpdates()
+
 	log.Println("starting polling for updates...")
 
 	go func() {
@@ -23,7 +27,10 @@ func (d *Dispatcher) StartPolling(skipUpdates bool) error {
 			case <-d.ctx.Done():
 				return
 			default:
-				params := bot.GetUpdatesOptions{Offset: d.nextOffset}
+				params := bot.GetUpdatesOptions{
+					Offset:         d.nextOffset,
+					AllowedUpdates: allowedUpdates,
+				}
 				updates, err := d.Bot.GetUpdates(params)
 				if err != nil {
 					if errors.Is(err, context.Canceled) {
@@ -0,0 +1,84 @@
+package dispatcher
+
+import (
+	filters "github.com/purkhanov/gogram/filter"
+	"github.com/purkhanov/gogram/types"
+)
+
+type messageReactionHandlerFunc func(*types.MessageReactionUpdated)
+
+type messageReactionHandler struct {
+	filters []filters.MessageReactionFilter
+	handler messageReactionHandlerFunc
+}
+
+type messageReactionCountHandlerFunc func(*types.MessageReactionCountUpdated)
+
+type messageReactionCountHandler struct {
+	filters []filters.MessageReactionCountFilter
+	handler messageReactionCountHandlerFunc
+}
+
+// OnMessageReaction registers a handler for reactions changed by users.
+// The bot must be an administrator in the chat to receive them.
+// Registering it makes the dispatcher request message_reaction updates.
+func (d *Dispatcher) OnMessageReaction(
+	handler messageReactionHandlerFunc, filters ...filters.MessageReactionFilter,
+) {
+	d.handlers.messageReactions = append(d.handlers.messageReactions, messageReactionHandler{
+		filters: filters,
+		handler: handler,
+	})
+}
+
+// OnMessageReactionCount registers a handler for changes of anonymous
+// reactions on messages. The bot must be an administrator in the chat
+// to receive them. The updates are grouped and can be sent with delay
+// up to a few minutes. Registering it makes the dispatcher request
+// message_reaction_count updates.
+func (d *Dispatcher) OnMessageReactionCount(
+	handler messageReactionCountHandlerFunc, filters ...filters.MessageReactionCountFilter,
+) {
+	d.handlers.messageReactionCounts = append(d.handlers.messageReactionCounts, messageReactionCountHandler{
+		filters: filters,
+		handler: handler,
+	})
+}
+
+func (d *Dispatcher) handleMessageReaction(reaction *types.MessageReactionUpdated) {
+	for _, handler := range d.handlers.messageReactions {
+		matches := true
+
+		for _, filter := range handler.filters {
+			if !filter(reaction) {
+				matches = false
+				break
+			}
+		}
+
+		if !matches {
+			continue
+		}
+
+		handler.handler(reaction)
+	}
+}
+
+func (d *Dispatcher) handleMessageReactionCount(reaction *types.MessageReactionCountUpdated) {
+	for _, handler := range d.handlers.messageReactionCounts {
+		matches := true
+
+		for _, filter := range handler.filters {
+			if !filter(reaction) {
+				matches = false
+				break
+			}
+		}
+
+		if !matches {
+			continue
+		}
+
+		handler.handler(reaction)
+	}
+}
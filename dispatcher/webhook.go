@@ -8,6 +8,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/purkhanov/gogram/api"
@@ -17,11 +18,38 @@ import (
 
 const webhookSecretToken = "X-Telegram-Bot-Api-Secret-Token"
 
+// SetWebhook sets the webhook of the bot, requesting the update
+// types handled by the dispatcher like polling does: when
+// options.AllowedUpdates is empty, AllowedUpdates is used instead,
+// otherwise the reaction updates with registered handlers are added.
+func (d *Dispatcher) SetWebhook(options bot.WebhookOptions) (string, error) {
+	allowed := d.AllowedUpdates()
+
+	if len(options.AllowedUpdates) == 0 {
+		options.AllowedUpdates = allowed
+	} else {
+		options.AllowedUpdates = slices.Clone(options.AllowedUpdates)
+
+		for _, update := range allowed {
+			if isReactionUpdate(update) && !slices.Contains(options.AllowedUpdates, update) {
+				options.AllowedUpdates = append(options.AllowedUpdates, update)
+			}
+		}
+	}
+
+	return d.Bot.SetWebhook(options)
+}
+
+// StartWebhookServer serves updates sent to the webhook. The webhook
+// itself is set with SetWebhook, so that it requests the update
+// types the dispatcher handles.
 func (d *Dispatcher) StartWebhookServer(port uint16, options bot.WebhookOptions) error {
 	if port == 0 {
 		return errors.New("port cannot be zero")
 	}
 
+	d.publishCommandsOnStart()
+
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
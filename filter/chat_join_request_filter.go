@@ -0,0 +1,22 @@
+package filters
+
+import (
+	"github.com/purkhanov/gogram/types"
+)
+
+type ChatJoinRequestFilter func(*types.ChatJoinRequest) bool
+
+// JoinRequestInChat matches join requests sent to the chat.
+func JoinRequestInChat(chatID types.ChatID) ChatJoinRequestFilter {
+	return func(r *types.ChatJoinRequest) bool {
+		return r.Chat.Matches(chatID)
+	}
+}
+
+// JoinRequestViaLink matches join requests sent using
+// an invite link with the given name.
+func JoinRequestViaLink(name string) ChatJoinRequestFilter {
+	return func(r *types.ChatJoinRequest) bool {
+		return r.InviteLink != nil && r.InviteLink.Name == name
+	}
+}
@@ -0,0 +1,153 @@
+package filters
+
+import (
+	"sync"
+	"time"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+// ChatMemberGetter is implemented by *bot.Bot.
+type ChatMemberGetter interface {
+	GetChatMember(chatID types.ChatID, userID int64) (types.ChatMember, error)
+}
+
+type chatMemberKey struct {
+	chatID types.ChatID
+	userID int64
+}
+
+// Entries checked for expiration on every insert. Map iteration
+// starts at a random entry, so the whole cache is swept over time
+// without holding the lock for a full scan.
+const chatMemberSweepSize = 32
+
+type chatMemberEntry struct {
+	member    types.ChatMember
+	expiresAt time.Time
+}
+
+// ChatMemberCache keeps results of getChatMember for a limited
+// time so that membership filters don't call the API on every update.
+type ChatMemberCache struct {
+	getter ChatMemberGetter
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	entries map[chatMemberKey]chatMemberEntry
+}
+
+func NewChatMemberCache(getter ChatMemberGetter, ttl time.Duration) *ChatMemberCache {
+	return &ChatMemberCache{
+		getter:  getter,
+		ttl:     ttl,
+		entries: make(map[chatMemberKey]chatMemberEntry),
+	}
+}
+
+// Get returns the cached member if it has not expired yet,
+// otherwise it requests the member from the API.
+func (c *ChatMemberCache) Get(chatID types.ChatID, userID int64) (types.ChatMember, error) {
+	key := chatMemberKey{chatID: chatID, userID: userID}
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok && !now.Before(entry.expiresAt) {
+		delete(c.entries, key)
+		ok = false
+	}
+	c.mu.Unlock()
+
+	if ok {
+		return entry.member, nil
+	}
+
+	member, err := c.getter.GetChatMember(chatID, userID)
+	if err != nil {
+		return types.ChatMember{}, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = chatMemberEntry{member: member, expiresAt: now.Add(c.ttl)}
+	c.sweep(now)
+	c.mu.Unlock()
+
+	return member, nil
+}
+
+// Invalidate drops the cached member, e.g. after promoting or banning the user.
+func (c *ChatMemberCache) Invalidate(chatID types.ChatID, userID int64) {
+	c.mu.Lock()
+	delete(c.entries, chatMemberKey{chatID: chatID, userID: userID})
+	c.mu.Unlock()
+}
+
+// sweep removes expired entries among a few of them.
+func (c *ChatMemberCache) sweep(now time.Time) {
+	checked := 0
+
+	for key, entry := range c.entries {
+		if checked == chatMemberSweepSize {
+			return
+		}
+		checked++
+
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// IsChatAdmin passes messages sent by the owner or an administrator
+// of the chat. Messages sent on behalf of the chat itself by its
+// anonymous administrators pass as well.
+func IsChatAdmin(cache *ChatMemberCache) MessageFilter {
+	return func(m *types.Message) bool {
+		if m.Chat == nil {
+			return false
+		}
+
+		if m.SenderChat != nil && m.SenderChat.ID == m.Chat.ID {
+			return true
+		}
+
+		member, ok := senderMember(cache, m.Chat.ChatID(), m)
+		return ok && member.IsAdmin()
+	}
+}
+
+// HasRight passes messages whose sender has the given
+// administrator right in the chat.
+func HasRight(cache *ChatMemberCache, right types.ChatRight) MessageFilter {
+	return func(m *types.Message) bool {
+		if m.Chat == nil {
+			return false
+		}
+
+		member, ok := senderMember(cache, m.Chat.ChatID(), m)
+		return ok && member.HasRight(right)
+	}
+}
+
+// IsChannelSubscriber passes messages whose sender is a member
+// of the given channel. The bot must be an administrator there.
+func IsChannelSubscriber(cache *ChatMemberCache, channelID types.ChatID) MessageFilter {
+	return func(m *types.Message) bool {
+		member, ok := senderMember(cache, channelID, m)
+		return ok && member.IsMember()
+	}
+}
+
+func senderMember(cache *ChatMemberCache, chatID types.ChatID, m *types.Message) (types.ChatMember, bool) {
+	if m.From == nil {
+		return types.ChatMember{}, false
+	}
+
+	member, err := cache.Get(chatID, m.From.ID)
+	if err != nil {
+		return types.ChatMember{}, false
+	}
+
+	return member, true
+}
@@ -0,0 +1,38 @@
+package filters
+
+import (
+	"github.com/purkhanov/gogram/types"
+)
+
+// InTopic matches messages sent to the forum topic with the
+// given message thread identifier.
+func InTopic(messageThreadID int) MessageFilter {
+	return func(m *types.Message) bool {
+		return m.IsTopicMessage && m.MessageThreadID == messageThreadID
+	}
+}
+
+// IsTopicMessage matches messages sent to any forum topic.
+func IsTopicMessage(m *types.Message) bool {
+	return m.IsTopicMessage
+}
+
+// ForumTopicCreated matches service messages about created topics.
+func ForumTopicCreated(m *types.Message) bool {
+	return m.ForumTopicCreated != nil
+}
+
+// ForumTopicEdited matches service messages about edited topics.
+func ForumTopicEdited(m *types.Message) bool {
+	return m.ForumTopicEdited != nil
+}
+
+// ForumTopicClosed matches service messages about closed topics.
+func ForumTopicClosed(m *types.Message) bool {
+	return m.ForumTopicClosed != nil
+}
+
+// ForumTopicReopened matches service messages about reopened topics.
+func ForumTopicReopened(m *types.Message) bool {
+	return m.ForumTopicReopened != nil
+}
@@ -0,0 +1,42 @@
+package filters
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+type InlineQueryFilter func(*types.InlineQuery) bool
+
+// QueryEquals matches inline queries with exactly the given text.
+func QueryEquals(query string) InlineQueryFilter {
+	return func(q *types.InlineQuery) bool {
+		return q.Query == query
+	}
+}
+
+// QueryPrefix matches inline queries starting with the prefix.
+func QueryPrefix(prefix string) InlineQueryFilter {
+	return func(q *types.InlineQuery) bool {
+		return strings.HasPrefix(q.Query, prefix)
+	}
+}
+
+// QueryMatches matches inline queries whose text matches the
+// regular expression. It panics if the expression can't be parsed.
+func QueryMatches(pattern string) InlineQueryFilter {
+	re := regexp.MustCompile(pattern)
+
+	return func(q *types.InlineQuery) bool {
+		return re.MatchString(q.Query)
+	}
+}
+
+// QueryEmpty matches inline queries with no text, sent
+// when the user has only typed the bot's username.
+func QueryEmpty() InlineQueryFilter {
+	return func(q *types.InlineQuery) bool {
+		return q.Query == ""
+	}
+}
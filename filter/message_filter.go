@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/purkhanov/gogram/format"
 	"github.com/purkhanov/gogram/types"
 )
 
@@ -28,8 +29,65 @@ func TextMatches(pattern string) MessageFilter {
 	}
 }
 
+// BotGetter is implemented by *bot.Bot.
+type BotGetter interface {
+	GetMe() (types.User, error)
+}
+
 func IsCommand(command types.Command) MessageFilter {
 	return func(m *types.Message) bool {
 		return m.Text == string(command)
 	}
 }
+
+// IsCommandFor matches messages that start with the command, with
+// or without arguments, including commands addressed to the bot as
+// /command@username. Commands addressed to other bots are not matched.
+func IsCommandFor(bot BotGetter, command types.Command) MessageFilter {
+	return func(m *types.Message) bool {
+		cmd, username, _, ok := types.ParseCommand(m.Text)
+		if !ok || cmd != command {
+			return false
+		}
+
+		if username == "" {
+			return true
+		}
+
+		me, err := bot.GetMe()
+		return err == nil && strings.EqualFold(username, me.Username)
+	}
+}
+
+// MentionsBot matches messages whose text or caption mentions
+// the bot by its @username or with a text mention.
+func MentionsBot(bot BotGetter) MessageFilter {
+	return func(m *types.Message) bool {
+		text, entities := format.MessageText(m)
+		if len(entities) == 0 {
+			return false
+		}
+
+		me, err := bot.GetMe()
+		if err != nil {
+			return false
+		}
+
+		for _, entity := range entities {
+			switch entity.Type {
+			case types.EntityTypeMention:
+				mention := format.EntityText(text, entity)
+				if strings.EqualFold(mention, "@"+me.Username) {
+					return true
+				}
+
+			case types.EntityTypeTextMention:
+				if entity.User != nil && entity.User.ID == me.ID {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+}
@@ -0,0 +1,37 @@
+package filters
+
+import (
+	"github.com/purkhanov/gogram/types"
+)
+
+type PollFilter func(*types.Poll) bool
+
+type PollAnswerFilter func(*types.PollAnswer) bool
+
+// PollWithID matches state updates of the poll.
+func PollWithID(pollID string) PollFilter {
+	return func(p *types.Poll) bool {
+		return p.ID == pollID
+	}
+}
+
+// PollClosed matches updates of closed polls.
+func PollClosed() PollFilter {
+	return func(p *types.Poll) bool {
+		return p.IsClosed
+	}
+}
+
+// AnswerToPoll matches answers to the poll.
+func AnswerToPoll(pollID string) PollAnswerFilter {
+	return func(a *types.PollAnswer) bool {
+		return a.PollID == pollID
+	}
+}
+
+// AnswerRetracted matches answers of users who retracted their vote.
+func AnswerRetracted() PollAnswerFilter {
+	return func(a *types.PollAnswer) bool {
+		return len(a.OptionIDs) == 0
+	}
+}
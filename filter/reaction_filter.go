@@ -0,0 +1,47 @@
+package filters
+
+import (
+	"slices"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+type MessageReactionFilter func(*types.MessageReactionUpdated) bool
+
+type MessageReactionCountFilter func(*types.MessageReactionCountUpdated) bool
+
+// ReactionInChat matches reaction changes in the chat.
+func ReactionInChat(chatID types.ChatID) MessageReactionFilter {
+	return func(r *types.MessageReactionUpdated) bool {
+		return r.Chat.Matches(chatID)
+	}
+}
+
+// ReactionAdded matches reaction changes where
+// the emoji reaction was added to the message.
+func ReactionAdded(emoji string) MessageReactionFilter {
+	return func(r *types.MessageReactionUpdated) bool {
+		return hasReaction(r.NewReaction, emoji) && !hasReaction(r.OldReaction, emoji)
+	}
+}
+
+// ReactionRemoved matches reaction changes where
+// the emoji reaction was removed from the message.
+func ReactionRemoved(emoji string) MessageReactionFilter {
+	return func(r *types.MessageReactionUpdated) bool {
+		return hasReaction(r.OldReaction, emoji) && !hasReaction(r.NewReaction, emoji)
+	}
+}
+
+// ReactionCountInChat matches changes of anonymous reactions in the chat.
+func ReactionCountInChat(chatID types.ChatID) MessageReactionCountFilter {
+	return func(r *types.MessageReactionCountUpdated) bool {
+		return r.Chat.Matches(chatID)
+	}
+}
+
+func hasReaction(reactions []types.ReactionType, emoji string) bool {
+	return slices.ContainsFunc(reactions, func(r types.ReactionType) bool {
+		return r.Is(emoji)
+	})
+}
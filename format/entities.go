@@ -0,0 +1,142 @@
+package format
+
+import (
+	"fmt"
+	"slices"
+	"unicode/utf16"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+// EntityText returns the part of the text covered by the entity.
+// Offsets of entities are in UTF-16 code units, so slicing the
+// Go string directly breaks on emoji and other non-BMP characters.
+func EntityText(text string, entity types.MessageEntity) string {
+	u := utf16.Encode([]rune(text))
+
+	start := min(max(entity.Offset, 0), len(u))
+	end := min(max(entity.Offset+entity.Length, start), len(u))
+
+	return string(utf16.Decode(u[start:end]))
+}
+
+// FilterEntities returns the entities of the given types, e.g.
+// FilterEntities(msg.Entities, types.EntityTypeURL, types.EntityTypeTextLink).
+func FilterEntities(entities []types.MessageEntity, entityTypes ...string) []types.MessageEntity {
+	var result []types.MessageEntity
+
+	for _, entity := range entities {
+		if slices.Contains(entityTypes, entity.Type) {
+			result = append(result, entity)
+		}
+	}
+
+	return result
+}
+
+// EntityTexts returns the text of every entity of the given
+// types, e.g. all hashtags or mentions of a message.
+func EntityTexts(text string, entities []types.MessageEntity, entityTypes ...string) []string {
+	var result []string
+
+	for _, entity := range FilterEntities(entities, entityTypes...) {
+		result = append(result, EntityText(text, entity))
+	}
+
+	return result
+}
+
+// MessageText returns the text of the message and its entities,
+// or the caption and caption entities for media messages.
+func MessageText(msg *types.Message) (string, []types.MessageEntity) {
+	if msg.Text != "" {
+		return msg.Text, msg.Entities
+	}
+
+	return msg.Caption, msg.CaptionEntities
+}
+
+// FromMessage turns the text or caption of the message into a node,
+// so it can be rendered again, e.g. to quote it or send it as a copy:
+//
+//	html := format.HTML(format.FromMessage(msg))
+func FromMessage(msg *types.Message) Node {
+	return Parse(MessageText(msg))
+}
+
+// Parse turns text with entities into a node. Entities that
+// only partially overlap other entities are cut at their borders.
+func Parse(text string, entities []types.MessageEntity) Node {
+	u := utf16.Encode([]rune(text))
+
+	sorted := slices.Clone(entities)
+	slices.SortStableFunc(sorted, func(a, b types.MessageEntity) int {
+		if a.Offset != b.Offset {
+			return a.Offset - b.Offset
+		}
+
+		return b.Length - a.Length
+	})
+
+	return Group(parseRange(u, sorted, 0, len(u))...)
+}
+
+// parseRange builds nodes for the units in [start, end). Entities
+// must be sorted by offset, longer entities go first.
+func parseRange(u []uint16, entities []types.MessageEntity, start, end int) []Node {
+	var nodes []Node
+	pos := start
+
+	for i := 0; i < len(entities); {
+		entity := entities[i]
+
+		entityStart := min(max(entity.Offset, pos), end)
+		entityEnd := min(entity.Offset+entity.Length, end)
+
+		// Entities starting inside this one are its children.
+		j := i + 1
+		for j < len(entities) && entities[j].Offset < entityEnd {
+			j++
+		}
+
+		if entityStart > pos {
+			nodes = append(nodes, textNode(utf16.Decode(u[pos:entityStart])))
+		}
+
+		if entityEnd > entityStart {
+			entity.Offset, entity.Length = 0, 0
+
+			nodes = append(nodes, entityNode{
+				entity:   entity,
+				children: parseRange(u, entities[i+1:j], entityStart, entityEnd),
+			})
+
+			pos = entityEnd
+		}
+
+		i = j
+	}
+
+	if pos < end {
+		nodes = append(nodes, textNode(utf16.Decode(u[pos:end])))
+	}
+
+	return nodes
+}
+
+// ParseText turns text in the given parse mode into plain text and
+// entities. Text without a parse mode is returned as is.
+func ParseText(mode types.ParseMode, text string) (string, []types.MessageEntity, error) {
+	switch mode {
+	case "":
+		return text, nil, nil
+	case types.ParseModeHTML:
+		return ParseHTML(text)
+	case types.ParseModeMarkdownV2:
+		return ParseMarkdownV2(text)
+	case types.ParseModeMarkdown:
+		return ParseMarkdown(text)
+	default:
+		return "", nil, fmt.Errorf("unknown parse mode %q", mode)
+	}
+}
@@ -0,0 +1,75 @@
+package format
+
+import (
+	"strings"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+var (
+	htmlEscaper = strings.NewReplacer(
+		"&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;",
+	)
+
+	markdownEscaper = strings.NewReplacer(
+		"_", `\_`, "*", `\*`, "`", "\\`", "[", `\[`,
+	)
+
+	markdownV2Escaper = strings.NewReplacer(
+		`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`,
+		")", `\)`, "~", `\~`, "`", "\\`", ">", `\>`, "#", `\#`, "+", `\+`,
+		"-", `\-`, "=", `\=`, "|", `\|`, "{", `\{`, "}", `\}`, ".", `\.`,
+		"!", `\!`,
+	)
+
+	// The legacy Markdown mode can't escape “)” in link URLs,
+	// so it is percent-encoded instead.
+	markdownLinkEscaper = strings.NewReplacer(")", "%29")
+
+	markdownV2CodeEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`")
+	markdownV2LinkEscaper = strings.NewReplacer(`\`, `\\`, ")", `\)`)
+)
+
+// EscapeHTML escapes the characters that have
+// a special meaning in the HTML parse mode.
+func EscapeHTML(text string) string {
+	return htmlEscaper.Replace(text)
+}
+
+// EscapeMarkdown escapes the characters that have a special
+// meaning in the legacy Markdown parse mode. Note that
+// entities can't be escaped inside other entities there.
+func EscapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// EscapeMarkdownV2 escapes all reserved characters of the MarkdownV2
+// parse mode. Use it for text outside of code, pre and link URLs.
+func EscapeMarkdownV2(text string) string {
+	return markdownV2Escaper.Replace(text)
+}
+
+// EscapeMarkdownV2Code escapes text inside code and pre entities.
+func EscapeMarkdownV2Code(text string) string {
+	return markdownV2CodeEscaper.Replace(text)
+}
+
+// EscapeMarkdownV2Link escapes the URL part of inline links and custom emoji.
+func EscapeMarkdownV2Link(url string) string {
+	return markdownV2LinkEscaper.Replace(url)
+}
+
+// Escape escapes plain text for the given parse mode.
+// Text is returned as is for an empty mode.
+func Escape(mode types.ParseMode, text string) string {
+	switch mode {
+	case types.ParseModeHTML:
+		return EscapeHTML(text)
+	case types.ParseModeMarkdown:
+		return EscapeMarkdown(text)
+	case types.ParseModeMarkdownV2:
+		return EscapeMarkdownV2(text)
+	default:
+		return text
+	}
+}
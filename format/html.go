@@ -0,0 +1,189 @@
+package format
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+var (
+	htmlTagRegexp  = regexp.MustCompile(`^<(/?)([a-zA-Z][a-zA-Z0-9-]*)((?:\s+[^>]*)?)>`)
+	htmlAttrRegexp = regexp.MustCompile(
+		`([a-zA-Z][a-zA-Z0-9-]*)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+)))?`,
+	)
+)
+
+type openTag struct {
+	name   string
+	offset int
+	entity types.MessageEntity
+}
+
+// ParseHTML turns text in the HTML parse mode into plain text
+// and entities, the same way Telegram does. It supports the
+// tags listed in https://core.telegram.org/bots/api#html-style.
+func ParseHTML(text string) (string, []types.MessageEntity, error) {
+	var (
+		sb       strings.Builder
+		offset   int
+		stack    []openTag
+		entities []types.MessageEntity
+	)
+
+	writeText := func(s string) {
+		s = html.UnescapeString(s)
+		sb.WriteString(s)
+		offset += UTF16Len(s)
+	}
+
+	for len(text) > 0 {
+		i := strings.IndexByte(text, '<')
+		if i < 0 {
+			writeText(text)
+			break
+		}
+
+		writeText(text[:i])
+		text = text[i:]
+
+		m := htmlTagRegexp.FindStringSubmatch(text)
+		if m == nil {
+			return "", nil, fmt.Errorf("unexpected \"<\" at %q", truncate(text))
+		}
+
+		text = text[len(m[0]):]
+		closing, name := m[1] == "/", strings.ToLower(m[2])
+
+		if closing {
+			n := len(stack) - 1
+			if n < 0 || stack[n].name != name {
+				return "", nil, fmt.Errorf("unexpected end tag </%s>", name)
+			}
+
+			tag := stack[n]
+			stack = stack[:n]
+
+			// <code> inside <pre> only sets the language of the block.
+			if name == "code" && n > 0 && stack[n-1].name == "pre" &&
+				stack[n-1].offset == tag.offset {
+				continue
+			}
+
+			if offset > tag.offset {
+				tag.entity.Offset = tag.offset
+				tag.entity.Length = offset - tag.offset
+				entities = append(entities, tag.entity)
+			}
+
+			continue
+		}
+
+		entity, err := htmlEntity(name, parseHTMLAttrs(m[3]))
+		if err != nil {
+			return "", nil, err
+		}
+
+		if name == "code" && len(stack) > 0 && stack[len(stack)-1].name == "pre" {
+			parent := &stack[len(stack)-1]
+			if parent.offset == offset {
+				if lang, ok := strings.CutPrefix(parseHTMLAttrs(m[3])["class"], "language-"); ok {
+					parent.entity.Language = lang
+				}
+			}
+		}
+
+		stack = append(stack, openTag{name: name, offset: offset, entity: entity})
+	}
+
+	if len(stack) > 0 {
+		return "", nil, fmt.Errorf("unclosed tag <%s>", stack[len(stack)-1].name)
+	}
+
+	slices.SortStableFunc(entities, func(a, b types.MessageEntity) int {
+		if a.Offset != b.Offset {
+			return a.Offset - b.Offset
+		}
+
+		return b.Length - a.Length
+	})
+
+	return sb.String(), entities, nil
+}
+
+func htmlEntity(name string, attrs map[string]string) (types.MessageEntity, error) {
+	entity := types.MessageEntity{}
+
+	switch name {
+	case "b", "strong":
+		entity.Type = types.EntityTypeBold
+	case "i", "em":
+		entity.Type = types.EntityTypeItalic
+	case "u", "ins":
+		entity.Type = types.EntityTypeUnderline
+	case "s", "strike", "del":
+		entity.Type = types.EntityTypeStrikethrough
+	case "tg-spoiler":
+		entity.Type = types.EntityTypeSpoiler
+	case "span":
+		if attrs["class"] != "tg-spoiler" {
+			return entity, fmt.Errorf("unsupported span class %q", attrs["class"])
+		}
+		entity.Type = types.EntityTypeSpoiler
+	case "code":
+		entity.Type = types.EntityTypeCode
+	case "pre":
+		entity.Type = types.EntityTypePre
+	case "blockquote":
+		entity.Type = types.EntityTypeBlockquote
+		if _, ok := attrs["expandable"]; ok {
+			entity.Type = types.EntityTypeExpandableBlockquote
+		}
+	case "tg-emoji":
+		entity.Type = types.EntityTypeCustomEmoji
+		entity.CustomEmojiID = attrs["emoji-id"]
+	case "a":
+		href := attrs["href"]
+
+		if id, ok := strings.CutPrefix(href, "tg://user?id="); ok {
+			userID, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				return entity, fmt.Errorf("invalid user link %q", href)
+			}
+
+			entity.Type = types.EntityTypeTextMention
+			entity.User = &types.User{ID: userID}
+		} else {
+			entity.Type = types.EntityTypeTextLink
+			entity.Url = href
+		}
+	default:
+		return entity, fmt.Errorf("unsupported tag <%s>", name)
+	}
+
+	return entity, nil
+}
+
+func parseHTMLAttrs(s string) map[string]string {
+	attrs := make(map[string]string)
+
+	for _, m := range htmlAttrRegexp.FindAllStringSubmatch(s, -1) {
+		attrs[strings.ToLower(m[1])] = html.UnescapeString(m[2] + m[3] + m[4])
+	}
+
+	return attrs
+}
+
+func truncate(s string) string {
+	const maxLen = 20
+
+	if len(s) > maxLen {
+		return s[:maxLen] + "…"
+	}
+
+	return s
+}
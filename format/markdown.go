@@ -0,0 +1,446 @@
+package format
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+// markdownParser turns text with markup into plain text and entities.
+type markdownParser struct {
+	src string
+	pos int
+
+	sb       strings.Builder
+	offset   int
+	stack    []markdownTag
+	entities []types.MessageEntity
+}
+
+// markdownTag is an open entity. Markers of entities with the same
+// range close in the reverse order, so index keeps the place of the
+// entity by the opening order, as Telegram does.
+type markdownTag struct {
+	openTag
+	index int
+}
+
+func (p *markdownParser) write(s string) {
+	p.sb.WriteString(s)
+	p.offset += UTF16Len(s)
+}
+
+// writeRune writes the character at the current position.
+func (p *markdownParser) writeRune() {
+	_, size := utf8.DecodeRuneInString(p.src[p.pos:])
+	p.write(p.src[p.pos : p.pos+size])
+	p.pos += size
+}
+
+func (p *markdownParser) top() *markdownTag {
+	if len(p.stack) == 0 {
+		return nil
+	}
+
+	return &p.stack[len(p.stack)-1]
+}
+
+func (p *markdownParser) isOpen(name string) bool {
+	return slices.ContainsFunc(p.stack, func(tag markdownTag) bool {
+		return tag.name == name
+	})
+}
+
+func (p *markdownParser) open(name string, entity types.MessageEntity) {
+	p.stack = append(p.stack, markdownTag{
+		openTag: openTag{name: name, offset: p.offset, entity: entity},
+		index:   len(p.entities),
+	})
+}
+
+// close ends the innermost entity, which must have been opened by name.
+func (p *markdownParser) close(name string) error {
+	tag := p.top()
+	if tag == nil || tag.name != name {
+		return fmt.Errorf("unexpected %q at byte %d", name, p.pos)
+	}
+
+	if p.offset > tag.offset {
+		tag.entity.Offset = tag.offset
+		tag.entity.Length = p.offset - tag.offset
+		p.entities = slices.Insert(p.entities, tag.index, tag.entity)
+	}
+
+	p.stack = p.stack[:len(p.stack)-1]
+	return nil
+}
+
+// toggle closes the entity opened by name or opens a new one.
+func (p *markdownParser) toggle(name, entityType string) error {
+	if p.isOpen(name) {
+		return p.close(name)
+	}
+
+	p.open(name, types.MessageEntity{Type: entityType})
+	return nil
+}
+
+// link reads the “(url)” part of an inline link.
+func (p *markdownParser) link(escapes bool) (string, error) {
+	if !strings.HasPrefix(p.src[p.pos:], "(") {
+		return "", fmt.Errorf("expected \"(\" after link text at byte %d", p.pos)
+	}
+	p.pos++
+
+	var url strings.Builder
+
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+
+		switch {
+		case c == ')':
+			p.pos++
+			return url.String(), nil
+
+		case escapes && c == '\\' && p.pos+1 < len(p.src):
+			url.WriteByte(p.src[p.pos+1])
+			p.pos += 2
+
+		default:
+			url.WriteByte(c)
+			p.pos++
+		}
+	}
+
+	return "", fmt.Errorf("unclosed link URL")
+}
+
+// preStart reads the language of a pre block right after “```”.
+// The language ends with the first line, if the block has more.
+func (p *markdownParser) preStart() string {
+	rest := p.src[p.pos:]
+
+	nl := strings.IndexByte(rest, '\n')
+	end := strings.Index(rest, "```")
+
+	if nl < 0 || (end >= 0 && end < nl) {
+		return ""
+	}
+
+	p.pos += nl + 1
+	return rest[:nl]
+}
+
+func (p *markdownParser) result() (string, []types.MessageEntity, error) {
+	if tag := p.top(); tag != nil {
+		return "", nil, fmt.Errorf("unclosed %q", tag.name)
+	}
+
+	slices.SortStableFunc(p.entities, func(a, b types.MessageEntity) int {
+		if a.Offset != b.Offset {
+			return a.Offset - b.Offset
+		}
+
+		return b.Length - a.Length
+	})
+
+	return p.sb.String(), p.entities, nil
+}
+
+func linkEntity(url string) (types.MessageEntity, error) {
+	if id, ok := strings.CutPrefix(url, "tg://user?id="); ok {
+		userID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return types.MessageEntity{}, fmt.Errorf("invalid user link %q", url)
+		}
+
+		return types.MessageEntity{
+			Type: types.EntityTypeTextMention,
+			User: &types.User{ID: userID},
+		}, nil
+	}
+
+	return types.MessageEntity{Type: types.EntityTypeTextLink, Url: url}, nil
+}
+
+// ParseMarkdownV2 turns text in the MarkdownV2 parse mode into plain
+// text and entities, the same way Telegram does. It supports the
+// syntax listed in https://core.telegram.org/bots/api#markdownv2-style.
+// Unlike Telegram, it doesn't reject unescaped reserved characters
+// that don't start an entity.
+func ParseMarkdownV2(text string) (string, []types.MessageEntity, error) {
+	p := &markdownParser{src: text}
+
+	for p.pos < len(p.src) {
+		if tag := p.top(); tag != nil && (tag.name == "`" || tag.name == "```") {
+			if err := p.codeV2(tag.name); err != nil {
+				return "", nil, err
+			}
+			continue
+		}
+
+		if p.pos == 0 || p.src[p.pos-1] == '\n' {
+			if p.quoteStartV2() {
+				continue
+			}
+		}
+
+		rest := p.src[p.pos:]
+
+		var err error
+
+		switch c := rest[0]; {
+		case c == '\\':
+			if len(rest) < 2 {
+				return "", nil, fmt.Errorf("unexpected end of text after \"\\\"")
+			}
+			p.pos++
+			p.writeRune()
+
+		case c == '\r':
+			// Separates ambiguous markers, ignored by Telegram
+			p.pos++
+
+		case c == '\n':
+			if p.isOpen(">") && !strings.HasPrefix(rest[1:], ">") {
+				err = p.close(">")
+			}
+			p.write("\n")
+			p.pos++
+
+		case c == '*':
+			err = p.toggle("*", types.EntityTypeBold)
+			p.pos++
+
+		case strings.HasPrefix(rest, "__"):
+			err = p.toggle("__", types.EntityTypeUnderline)
+			p.pos += 2
+
+		case c == '_':
+			err = p.toggle("_", types.EntityTypeItalic)
+			p.pos++
+
+		case c == '~':
+			err = p.toggle("~", types.EntityTypeStrikethrough)
+			p.pos++
+
+		case strings.HasPrefix(rest, "||"):
+			p.pos += 2
+
+			// “||” at the end of a line closes an expandable blockquote
+			end := p.pos == len(p.src) || p.src[p.pos] == '\n'
+			if tag := p.top(); end && tag != nil && tag.name == ">" &&
+				tag.entity.Type == types.EntityTypeExpandableBlockquote {
+				err = p.close(">")
+			} else {
+				err = p.toggle("||", types.EntityTypeSpoiler)
+			}
+
+		case c == '[':
+			p.open("[", types.MessageEntity{})
+			p.pos++
+
+		case strings.HasPrefix(rest, "!["):
+			p.open("![", types.MessageEntity{})
+			p.pos += 2
+
+		case c == ']' && (p.isOpen("[") || p.isOpen("![")):
+			err = p.linkEndV2()
+
+		case strings.HasPrefix(rest, "```"):
+			p.pos += 3
+			p.open("```", types.MessageEntity{Type: types.EntityTypePre, Language: p.preStart()})
+
+		case c == '`':
+			p.open("`", types.MessageEntity{Type: types.EntityTypeCode})
+			p.pos++
+
+		default:
+			p.writeRune()
+		}
+
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if p.isOpen(">") {
+		if err := p.close(">"); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return p.result()
+}
+
+// quoteStartV2 opens a blockquote at the start of a line.
+func (p *markdownParser) quoteStartV2() bool {
+	rest := p.src[p.pos:]
+
+	switch {
+	case strings.HasPrefix(rest, "**>") && !p.isOpen(">"):
+		p.open(">", types.MessageEntity{Type: types.EntityTypeExpandableBlockquote})
+		p.pos += 3
+		return true
+
+	case strings.HasPrefix(rest, ">"):
+		if !p.isOpen(">") {
+			p.open(">", types.MessageEntity{Type: types.EntityTypeBlockquote})
+		}
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+// codeV2 reads text inside code and pre entities, where
+// only “`” and “\” have to be escaped.
+func (p *markdownParser) codeV2(name string) error {
+	rest := p.src[p.pos:]
+
+	switch {
+	case len(rest) > 1 && rest[0] == '\\' && (rest[1] == '`' || rest[1] == '\\'):
+		p.write(rest[1:2])
+		p.pos += 2
+
+	case name == "```" && strings.HasPrefix(rest, "\n```"):
+		// The line break before the closing “```” isn't part of the block
+		p.pos++
+
+	case name == "```" && strings.HasPrefix(rest, "```"):
+		p.pos += 3
+		return p.close("```")
+
+	case name == "`" && rest[0] == '`':
+		p.pos++
+		return p.close("`")
+
+	default:
+		p.writeRune()
+	}
+
+	return nil
+}
+
+// linkEndV2 reads the end of an inline link or custom emoji.
+func (p *markdownParser) linkEndV2() error {
+	name := p.top().name
+	p.pos++
+
+	url, err := p.link(true)
+	if err != nil {
+		return err
+	}
+
+	if name == "![" {
+		id, ok := strings.CutPrefix(url, "tg://emoji?id=")
+		if !ok {
+			return fmt.Errorf("invalid custom emoji URL %q", url)
+		}
+
+		p.top().entity = types.MessageEntity{Type: types.EntityTypeCustomEmoji, CustomEmojiID: id}
+		return p.close(name)
+	}
+
+	if p.top().name != "[" {
+		return fmt.Errorf("unexpected \"]\" at byte %d", p.pos)
+	}
+
+	entity, err := linkEntity(url)
+	if err != nil {
+		return err
+	}
+
+	p.top().entity = entity
+	return p.close(name)
+}
+
+// ParseMarkdown turns text in the legacy Markdown parse mode into
+// plain text and entities. Entities can't be nested in this mode,
+// and the text inside them is taken as is.
+func ParseMarkdown(text string) (string, []types.MessageEntity, error) {
+	p := &markdownParser{src: text}
+
+	markers := map[byte]string{
+		'*': types.EntityTypeBold,
+		'_': types.EntityTypeItalic,
+		'`': types.EntityTypeCode,
+	}
+
+	for p.pos < len(p.src) {
+		rest := p.src[p.pos:]
+		c := rest[0]
+
+		switch {
+		case c == '\\' && len(rest) > 1 && strings.IndexByte("_*`[", rest[1]) >= 0:
+			p.write(rest[1:2])
+			p.pos += 2
+
+		case strings.HasPrefix(rest, "```"):
+			p.pos += 3
+			entity := types.MessageEntity{Type: types.EntityTypePre, Language: p.preStart()}
+
+			end := strings.Index(p.src[p.pos:], "```")
+			if end < 0 {
+				return "", nil, fmt.Errorf("unclosed \"```\"")
+			}
+
+			p.open("```", entity)
+			p.write(p.src[p.pos : p.pos+end])
+			p.pos += end + 3
+
+			if err := p.close("```"); err != nil {
+				return "", nil, err
+			}
+
+		case markers[c] != "":
+			end := strings.IndexByte(rest[1:], c)
+			if end < 0 {
+				return "", nil, fmt.Errorf("unclosed %q", string(c))
+			}
+
+			p.open(string(c), types.MessageEntity{Type: markers[c]})
+			p.write(rest[1 : end+1])
+			p.pos += end + 2
+
+			if err := p.close(string(c)); err != nil {
+				return "", nil, err
+			}
+
+		case c == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return "", nil, fmt.Errorf("unclosed \"[\"")
+			}
+
+			p.open("[", types.MessageEntity{})
+			p.write(rest[1:end])
+			p.pos += end + 1
+
+			url, err := p.link(false)
+			if err != nil {
+				return "", nil, err
+			}
+
+			entity, err := linkEntity(url)
+			if err != nil {
+				return "", nil, err
+			}
+
+			p.top().entity = entity
+			if err := p.close("["); err != nil {
+				return "", nil, err
+			}
+
+		default:
+			p.writeRune()
+		}
+	}
+
+	return p.result()
+}
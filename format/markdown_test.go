@@ -0,0 +1,184 @@
+package format
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+type entity = types.MessageEntity
+
+func TestParseMarkdownV2(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		want     string
+		entities []entity
+	}{
+		{"plain", "hello", "hello", nil},
+		{"escaped", `1\.5 \*x\*`, "1.5 *x*", nil},
+		{
+			"nested",
+			"*bold _italic_*",
+			"bold italic",
+			[]entity{
+				{Type: types.EntityTypeBold, Offset: 0, Length: 11},
+				{Type: types.EntityTypeItalic, Offset: 5, Length: 6},
+			},
+		},
+		{
+			"italic underline",
+			"_\r__x__\r_",
+			"x",
+			[]entity{
+				{Type: types.EntityTypeItalic, Offset: 0, Length: 1},
+				{Type: types.EntityTypeUnderline, Offset: 0, Length: 1},
+			},
+		},
+		{
+			"emoji offsets",
+			"😀 ~s~ ||p||",
+			"😀 s p",
+			[]entity{
+				{Type: types.EntityTypeStrikethrough, Offset: 3, Length: 1},
+				{Type: types.EntityTypeSpoiler, Offset: 5, Length: 1},
+			},
+		},
+		{
+			"link",
+			`[a](https://x.y/\(1\))`,
+			"a",
+			[]entity{{Type: types.EntityTypeTextLink, Offset: 0, Length: 1, Url: "https://x.y/(1)"}},
+		},
+		{
+			"mention",
+			"[a](tg://user?id=42)",
+			"a",
+			[]entity{{Type: types.EntityTypeTextMention, Offset: 0, Length: 1, User: &types.User{ID: 42}}},
+		},
+		{
+			"custom emoji",
+			"![👍](tg://emoji?id=5)",
+			"👍",
+			[]entity{{Type: types.EntityTypeCustomEmoji, Offset: 0, Length: 2, CustomEmojiID: "5"}},
+		},
+		{
+			"code",
+			"`a*b\\``",
+			"a*b`",
+			[]entity{{Type: types.EntityTypeCode, Offset: 0, Length: 4}},
+		},
+		{
+			"pre",
+			"```go\nx := 1\n```",
+			"x := 1",
+			[]entity{{Type: types.EntityTypePre, Offset: 0, Length: 6, Language: "go"}},
+		},
+		{
+			"blockquote",
+			">a\n>b\nc",
+			"a\nb\nc",
+			[]entity{{Type: types.EntityTypeBlockquote, Offset: 0, Length: 3}},
+		},
+		{
+			"expandable blockquote",
+			"**>a\n>b||\nc",
+			"a\nb\nc",
+			[]entity{{Type: types.EntityTypeExpandableBlockquote, Offset: 0, Length: 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, entities, err := ParseMarkdownV2(tt.text)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if text != tt.want {
+				t.Errorf("text = %q, want %q", text, tt.want)
+			}
+
+			if !reflect.DeepEqual(entities, tt.entities) {
+				t.Errorf("entities = %+v, want %+v", entities, tt.entities)
+			}
+		})
+	}
+}
+
+func TestParseMarkdownV2Errors(t *testing.T) {
+	for _, text := range []string{"*bold", "*a _b* c_", "[a](b", `a\`} {
+		if _, _, err := ParseMarkdownV2(text); err == nil {
+			t.Errorf("ParseMarkdownV2(%q) returned no error", text)
+		}
+	}
+}
+
+func TestParseMarkdownV2RoundTrip(t *testing.T) {
+	node := Group(
+		Bold(Text("a_b "), Italic(Underline(Text("c")))),
+		Text(" (1+1=2) "),
+		Link("https://x.y/(1)", Text("link")),
+	)
+
+	text, entities, err := ParseMarkdownV2(Render(types.ParseModeMarkdownV2, node))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantText, wantEntities := Entities(node)
+	if text != wantText || !reflect.DeepEqual(entities, wantEntities) {
+		t.Errorf("got %q %+v, want %q %+v", text, entities, wantText, wantEntities)
+	}
+}
+
+func TestParseMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		want     string
+		entities []entity
+	}{
+		{"escaped", `a\_b`, "a_b", nil},
+		{
+			"entities",
+			"*b* _i_ `c_d`",
+			"b i c_d",
+			[]entity{
+				{Type: types.EntityTypeBold, Offset: 0, Length: 1},
+				{Type: types.EntityTypeItalic, Offset: 2, Length: 1},
+				{Type: types.EntityTypeCode, Offset: 4, Length: 3},
+			},
+		},
+		{
+			"link",
+			"[a](https://x.y)",
+			"a",
+			[]entity{{Type: types.EntityTypeTextLink, Offset: 0, Length: 1, Url: "https://x.y"}},
+		},
+		{
+			"pre",
+			"```py\nx = 1```",
+			"x = 1",
+			[]entity{{Type: types.EntityTypePre, Offset: 0, Length: 5, Language: "py"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, entities, err := ParseMarkdown(tt.text)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if text != tt.want {
+				t.Errorf("text = %q, want %q", text, tt.want)
+			}
+
+			if !reflect.DeepEqual(entities, tt.entities) {
+				t.Errorf("entities = %+v, want %+v", entities, tt.entities)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Package format builds formatted message text. The same text can
+// be rendered for any parse mode or as plain text with entities:
+//
+//	text := format.Group(
+//		format.Bold(format.Text("Order #42")),
+//		format.Text(" is ready, "),
+//		format.Link("https://example.com/orders/42", format.Text("open it")),
+//	)
+//
+//	html := format.Render(types.ParseModeHTML, text)
+//	plain, entities := format.Entities(text)
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+// Node is a piece of formatted text.
+type Node interface {
+	isNode()
+}
+
+// textNode is plain text.
+type textNode string
+
+// entityNode is a formatted piece of text. Nodes
+// with an empty entity type only group children.
+type entityNode struct {
+	entity   types.MessageEntity
+	children []Node
+}
+
+func (textNode) isNode()   {}
+func (entityNode) isNode() {}
+
+// Text is plain text, it is escaped when rendered.
+func Text(text string) Node {
+	return textNode(text)
+}
+
+// Textf is plain text formatted with fmt.Sprintf.
+func Textf(format string, args ...any) Node {
+	return textNode(fmt.Sprintf(format, args...))
+}
+
+// Group joins nodes without any formatting.
+func Group(children ...Node) Node {
+	return entityNode{children: children}
+}
+
+// Lines joins nodes with new lines.
+func Lines(lines ...Node) Node {
+	children := make([]Node, 0, 2*len(lines))
+
+	for i, line := range lines {
+		if i > 0 {
+			children = append(children, textNode("\n"))
+		}
+
+		children = append(children, line)
+	}
+
+	return entityNode{children: children}
+}
+
+func Bold(children ...Node) Node {
+	return newEntity(types.EntityTypeBold, children)
+}
+
+func Italic(children ...Node) Node {
+	return newEntity(types.EntityTypeItalic, children)
+}
+
+func Underline(children ...Node) Node {
+	return newEntity(types.EntityTypeUnderline, children)
+}
+
+func Strikethrough(children ...Node) Node {
+	return newEntity(types.EntityTypeStrikethrough, children)
+}
+
+func Spoiler(children ...Node) Node {
+	return newEntity(types.EntityTypeSpoiler, children)
+}
+
+func Blockquote(children ...Node) Node {
+	return newEntity(types.EntityTypeBlockquote, children)
+}
+
+// ExpandableBlockquote is a block quotation collapsed by default.
+func ExpandableBlockquote(children ...Node) Node {
+	return newEntity(types.EntityTypeExpandableBlockquote, children)
+}
+
+// Code is an inline monowidth string.
+func Code(code string) Node {
+	return newEntity(types.EntityTypeCode, []Node{textNode(code)})
+}
+
+// Pre is a monowidth block with an optional programming language.
+func Pre(code, language string) Node {
+	return entityNode{
+		entity:   types.MessageEntity{Type: types.EntityTypePre, Language: language},
+		children: []Node{textNode(code)},
+	}
+}
+
+// Link is a clickable text URL.
+func Link(url string, children ...Node) Node {
+	return entityNode{
+		entity:   types.MessageEntity{Type: types.EntityTypeTextLink, Url: url},
+		children: children,
+	}
+}
+
+// Mention mentions a user by their identifier, which works
+// for users without usernames too. If no children are given,
+// the full name of the user is used as the text.
+func Mention(user types.User, children ...Node) Node {
+	if len(children) == 0 {
+		name := strings.TrimSpace(user.FirstName + " " + user.LastName)
+		children = []Node{textNode(name)}
+	}
+
+	return entityNode{
+		entity:   types.MessageEntity{Type: types.EntityTypeTextMention, User: &user},
+		children: children,
+	}
+}
+
+// CustomEmoji shows a custom emoji sticker. The emoji is shown
+// instead of the sticker where custom emoji are not supported.
+func CustomEmoji(emoji, customEmojiID string) Node {
+	return entityNode{
+		entity: types.MessageEntity{
+			Type:          types.EntityTypeCustomEmoji,
+			CustomEmojiID: customEmojiID,
+		},
+		children: []Node{textNode(emoji)},
+	}
+}
+
+func newEntity(entityType string, children []Node) Node {
+	return entityNode{
+		entity:   types.MessageEntity{Type: entityType},
+		children: children,
+	}
+}
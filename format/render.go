@@ -0,0 +1,280 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+// Render renders the nodes as text for the given parse mode.
+// Entities not supported by the legacy Markdown mode are
+// rendered as plain text. An empty mode renders plain text.
+func Render(mode types.ParseMode, nodes ...Node) string {
+	var sb strings.Builder
+
+	for _, node := range nodes {
+		switch mode {
+		case types.ParseModeHTML:
+			writeHTML(&sb, node)
+		case types.ParseModeMarkdownV2:
+			writeMarkdownV2(&sb, node)
+		case types.ParseModeMarkdown:
+			writeMarkdown(&sb, node)
+		default:
+			writePlain(&sb, node)
+		}
+	}
+
+	return sb.String()
+}
+
+func HTML(nodes ...Node) string {
+	return Render(types.ParseModeHTML, nodes...)
+}
+
+func MarkdownV2(nodes ...Node) string {
+	return Render(types.ParseModeMarkdownV2, nodes...)
+}
+
+// Entities renders the nodes as plain text and the list
+// of entities with offsets in UTF-16 code units.
+func Entities(nodes ...Node) (string, []types.MessageEntity) {
+	w := entityWriter{}
+
+	for _, node := range nodes {
+		w.write(node)
+	}
+
+	return w.sb.String(), w.entities
+}
+
+func writePlain(sb *strings.Builder, node Node) {
+	switch n := node.(type) {
+	case textNode:
+		sb.WriteString(string(n))
+	case entityNode:
+		for _, child := range n.children {
+			writePlain(sb, child)
+		}
+	}
+}
+
+func renderChildren(children []Node, write func(*strings.Builder, Node)) string {
+	var sb strings.Builder
+
+	for _, child := range children {
+		write(&sb, child)
+	}
+
+	return sb.String()
+}
+
+func writeHTML(sb *strings.Builder, node Node) {
+	switch n := node.(type) {
+	case textNode:
+		sb.WriteString(EscapeHTML(string(n)))
+		return
+	case entityNode:
+		inner := renderChildren(n.children, writeHTML)
+		e := n.entity
+
+		switch e.Type {
+		case types.EntityTypeBold:
+			fmt.Fprintf(sb, "<b>%s</b>", inner)
+		case types.EntityTypeItalic:
+			fmt.Fprintf(sb, "<i>%s</i>", inner)
+		case types.EntityTypeUnderline:
+			fmt.Fprintf(sb, "<u>%s</u>", inner)
+		case types.EntityTypeStrikethrough:
+			fmt.Fprintf(sb, "<s>%s</s>", inner)
+		case types.EntityTypeSpoiler:
+			fmt.Fprintf(sb, "<tg-spoiler>%s</tg-spoiler>", inner)
+		case types.EntityTypeBlockquote:
+			fmt.Fprintf(sb, "<blockquote>%s</blockquote>", inner)
+		case types.EntityTypeExpandableBlockquote:
+			fmt.Fprintf(sb, "<blockquote expandable>%s</blockquote>", inner)
+		case types.EntityTypeCode:
+			fmt.Fprintf(sb, "<code>%s</code>", inner)
+		case types.EntityTypePre:
+			if e.Language != "" {
+				fmt.Fprintf(
+					sb, `<pre><code class="language-%s">%s</code></pre>`,
+					EscapeHTML(e.Language), inner,
+				)
+			} else {
+				fmt.Fprintf(sb, "<pre>%s</pre>", inner)
+			}
+		case types.EntityTypeTextLink:
+			fmt.Fprintf(sb, `<a href="%s">%s</a>`, EscapeHTML(e.Url), inner)
+		case types.EntityTypeTextMention:
+			fmt.Fprintf(sb, `<a href="%s">%s</a>`, userURL(e.User), inner)
+		case types.EntityTypeCustomEmoji:
+			fmt.Fprintf(
+				sb, `<tg-emoji emoji-id="%s">%s</tg-emoji>`,
+				EscapeHTML(e.CustomEmojiID), inner,
+			)
+		default:
+			sb.WriteString(inner)
+		}
+	}
+}
+
+func writeMarkdownV2(sb *strings.Builder, node Node) {
+	switch n := node.(type) {
+	case textNode:
+		sb.WriteString(EscapeMarkdownV2(string(n)))
+		return
+	case entityNode:
+		e := n.entity
+
+		switch e.Type {
+		case types.EntityTypeCode:
+			sb.WriteString("`" + EscapeMarkdownV2Code(plainText(n.children)) + "`")
+			return
+		case types.EntityTypePre:
+			code := EscapeMarkdownV2Code(plainText(n.children))
+			fmt.Fprintf(sb, "```%s\n%s\n```", e.Language, code)
+			return
+		}
+
+		inner := renderChildren(n.children, writeMarkdownV2)
+
+		switch e.Type {
+		case types.EntityTypeBold:
+			sb.WriteString("*" + inner + "*")
+		case types.EntityTypeItalic:
+			// "___" is read greedily as underline first, so an
+			// underline entity opening right after the italic one
+			// is separated by \r, which Telegram ignores.
+			if strings.HasPrefix(inner, "_") {
+				inner = "\r" + inner
+			}
+			sb.WriteString("_" + inner + "_")
+		case types.EntityTypeUnderline:
+			// The same for an italic entity closing right
+			// before the underline one. Escaped underscores
+			// start with a backslash, so they never match.
+			if strings.HasSuffix(inner, "_") {
+				inner += "\r"
+			}
+			sb.WriteString("__" + inner + "__")
+		case types.EntityTypeStrikethrough:
+			sb.WriteString("~" + inner + "~")
+		case types.EntityTypeSpoiler:
+			sb.WriteString("||" + inner + "||")
+		case types.EntityTypeBlockquote:
+			sb.WriteString(quoteLines(inner, ">"))
+		case types.EntityTypeExpandableBlockquote:
+			sb.WriteString("**" + quoteLines(inner, ">") + "||")
+		case types.EntityTypeTextLink:
+			fmt.Fprintf(sb, "[%s](%s)", inner, EscapeMarkdownV2Link(e.Url))
+		case types.EntityTypeTextMention:
+			fmt.Fprintf(sb, "[%s](%s)", inner, userURL(e.User))
+		case types.EntityTypeCustomEmoji:
+			fmt.Fprintf(
+				sb, "![%s](tg://emoji?id=%s)",
+				inner, EscapeMarkdownV2Link(e.CustomEmojiID),
+			)
+		default:
+			sb.WriteString(inner)
+		}
+	}
+}
+
+// writeMarkdown renders the legacy Markdown mode, which
+// doesn't support nested entities, so entities inside
+// other entities are rendered as plain text.
+func writeMarkdown(sb *strings.Builder, node Node) {
+	switch n := node.(type) {
+	case textNode:
+		sb.WriteString(EscapeMarkdown(string(n)))
+		return
+	case entityNode:
+		e := n.entity
+		inner := plainText(n.children)
+
+		switch e.Type {
+		case types.EntityTypeBold:
+			sb.WriteString("*" + inner + "*")
+		case types.EntityTypeItalic:
+			sb.WriteString("_" + inner + "_")
+		case types.EntityTypeCode:
+			sb.WriteString("`" + inner + "`")
+		case types.EntityTypePre:
+			fmt.Fprintf(sb, "```%s\n%s```", e.Language, inner)
+		case types.EntityTypeTextLink:
+			fmt.Fprintf(sb, "[%s](%s)", inner, markdownLinkEscaper.Replace(e.Url))
+		case types.EntityTypeTextMention:
+			fmt.Fprintf(sb, "[%s](%s)", inner, userURL(e.User))
+		default:
+			if e.Type == "" {
+				for _, child := range n.children {
+					writeMarkdown(sb, child)
+				}
+			} else {
+				sb.WriteString(EscapeMarkdown(inner))
+			}
+		}
+	}
+}
+
+type entityWriter struct {
+	sb       strings.Builder
+	offset   int
+	entities []types.MessageEntity
+}
+
+func (w *entityWriter) write(node Node) {
+	switch n := node.(type) {
+	case textNode:
+		w.sb.WriteString(string(n))
+		w.offset += UTF16Len(string(n))
+	case entityNode:
+		if n.entity.Type == "" {
+			for _, child := range n.children {
+				w.write(child)
+			}
+			return
+		}
+
+		index := len(w.entities)
+		start := w.offset
+
+		w.entities = append(w.entities, n.entity)
+
+		for _, child := range n.children {
+			w.write(child)
+		}
+
+		if w.offset == start {
+			w.entities = append(w.entities[:index], w.entities[index+1:]...)
+			return
+		}
+
+		w.entities[index].Offset = start
+		w.entities[index].Length = w.offset - start
+	}
+}
+
+func plainText(nodes []Node) string {
+	return renderChildren(nodes, writePlain)
+}
+
+func quoteLines(text, prefix string) string {
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func userURL(user *types.User) string {
+	if user == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("tg://user?id=%d", user.ID)
+}
@@ -0,0 +1,165 @@
+package format
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		name   string
+		escape func(string) string
+		text   string
+		want   string
+	}{
+		{"html", EscapeHTML, `<a href="x">&</a>`, "&lt;a href=&quot;x&quot;&gt;&amp;&lt;/a&gt;"},
+		{"markdown", EscapeMarkdown, "_*`[]", "\\_\\*\\`\\[]"},
+		{
+			"markdown v2", EscapeMarkdownV2,
+			"_*[]()~`>#+-=|{}.!\\",
+			"\\_\\*\\[\\]\\(\\)\\~\\`\\>\\#\\+\\-\\=\\|\\{\\}\\.\\!\\\\",
+		},
+		{"markdown v2 plain", EscapeMarkdownV2, "Привет, мир 👋", "Привет, мир 👋"},
+		{"markdown v2 code", EscapeMarkdownV2Code, "a`b\\c*d", "a\\`b\\\\c*d"},
+		{"markdown v2 link", EscapeMarkdownV2Link, "https://e.com/(a)\\", "https://e.com/(a\\)\\\\"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.escape(tt.text); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		mode types.ParseMode
+		node Node
+		want string
+	}{
+		{
+			"html", types.ParseModeHTML,
+			Group(Bold(Text("<1>")), Link("https://e.com/?a=1&b=2", Text("x"))),
+			`<b>&lt;1&gt;</b><a href="https://e.com/?a=1&amp;b=2">x</a>`,
+		},
+		{
+			"markdown v2 text", types.ParseModeMarkdownV2,
+			Group(Text("1+1=2. "), Bold(Text("a_b"))),
+			"1\\+1\\=2\\. *a\\_b*",
+		},
+		{
+			"markdown v2 italic underline", types.ParseModeMarkdownV2,
+			Italic(Underline(Text("x"))),
+			"_\r__x___",
+		},
+		{
+			"markdown v2 underline italic", types.ParseModeMarkdownV2,
+			Underline(Italic(Text("x"))),
+			"___x_\r__",
+		},
+		{
+			"markdown v2 underline escaped underscore", types.ParseModeMarkdownV2,
+			Underline(Text("x_")),
+			"__x\\_\r__",
+		},
+		{
+			"markdown v2 link", types.ParseModeMarkdownV2,
+			Link("https://e.com/(a)", Text("a.b")),
+			"[a\\.b](https://e.com/(a\\))",
+		},
+		{
+			"markdown v2 code", types.ParseModeMarkdownV2,
+			Group(Code("a`b"), Pre("x*y\n", "go")),
+			"`a\\`b````go\nx*y\n\n```",
+		},
+		{
+			"markdown v2 blockquote", types.ParseModeMarkdownV2,
+			Blockquote(Text("a\nb")),
+			">a\n>b",
+		},
+		{
+			"markdown link", types.ParseModeMarkdown,
+			Link("https://e.com/a_(b)", Text("t")),
+			"[t](https://e.com/a_(b%29)",
+		},
+		{
+			"markdown nested", types.ParseModeMarkdown,
+			Bold(Text("a"), Italic(Text("b_c"))),
+			"*ab_c*",
+		},
+		{
+			"plain", "",
+			Group(Bold(Text("a")), Text("<b>")),
+			"a<b>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Render(tt.mode, tt.node); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntities(t *testing.T) {
+	tests := []struct {
+		name         string
+		node         Node
+		wantText     string
+		wantEntities []types.MessageEntity
+	}{
+		{
+			"utf16 offsets",
+			Group(Text("👍 "), Bold(Text("hi")), Text(" "), Italic(Text("é😀"))),
+			"👍 hi é😀",
+			[]types.MessageEntity{
+				{Type: types.EntityTypeBold, Offset: 3, Length: 2},
+				{Type: types.EntityTypeItalic, Offset: 6, Length: 3},
+			},
+		},
+		{
+			"nested",
+			Bold(Text("a"), Italic(Text("b"))),
+			"ab",
+			[]types.MessageEntity{
+				{Type: types.EntityTypeBold, Offset: 0, Length: 2},
+				{Type: types.EntityTypeItalic, Offset: 1, Length: 1},
+			},
+		},
+		{
+			"empty entity dropped",
+			Group(Text("a"), Bold(), Text("b")),
+			"ab",
+			[]types.MessageEntity{},
+		},
+		{
+			"pre language",
+			Pre("x", "go"),
+			"x",
+			[]types.MessageEntity{
+				{Type: types.EntityTypePre, Offset: 0, Length: 1, Language: "go"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, entities := Entities(tt.node)
+
+			if text != tt.wantText {
+				t.Errorf("got text %q, want %q", text, tt.wantText)
+			}
+
+			if !reflect.DeepEqual(entities, tt.wantEntities) {
+				t.Errorf("got entities %+v, want %+v", entities, tt.wantEntities)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package format
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+const (
+	// MaxMessageLength is the maximum length of a message
+	// text in UTF-16 code units after entities parsing.
+	MaxMessageLength = 4096
+
+	// MaxCaptionLength is the maximum length of a media
+	// caption in UTF-16 code units after entities parsing.
+	MaxCaptionLength = 1024
+)
+
+// Chunk is a part of a split text with its own entities.
+type Chunk struct {
+	Text     string
+	Entities []types.MessageEntity
+}
+
+// separators in the order of preference for splitting.
+var separators = [][]uint16{
+	utf16.Encode([]rune("\n\n")),
+	utf16.Encode([]rune("\n")),
+	utf16.Encode([]rune(" ")),
+}
+
+// Split splits text into chunks of at most limit UTF-16 code units.
+// Text is split on paragraph, line or word boundaries outside of
+// entities where possible. An entity longer than the limit is cut
+// into parts, each of them keeps the entity, so formatting stays
+// balanced across chunks. Whitespace chunks are dropped.
+func Split(text string, entities []types.MessageEntity, limit int) []Chunk {
+	return split(text, entities, limit, limit)
+}
+
+// SplitCaption splits a long media caption. The first chunk fits
+// into MaxCaptionLength and goes to the media, the rest fit into
+// MaxMessageLength and are meant to be sent as text messages.
+func SplitCaption(text string, entities []types.MessageEntity) []Chunk {
+	return split(text, entities, MaxCaptionLength, MaxMessageLength)
+}
+
+// split works like Split, but the first chunk has its own limit.
+func split(text string, entities []types.MessageEntity, firstLimit, limit int) []Chunk {
+	u := utf16.Encode([]rune(text))
+
+	if len(u) <= firstLimit || firstLimit <= 0 || limit <= 0 {
+		return []Chunk{{Text: text, Entities: entities}}
+	}
+
+	var chunks []Chunk
+
+	for start := 0; start < len(u); {
+		chunkLimit := limit
+		if start == 0 {
+			chunkLimit = firstLimit
+		}
+
+		end, next := len(u), len(u)
+		if len(u)-start > chunkLimit {
+			end, next = findCut(u, entities, start, chunkLimit)
+		}
+
+		chunk := Chunk{
+			Text:     string(utf16.Decode(u[start:end])),
+			Entities: clipEntities(entities, start, end),
+		}
+
+		if strings.TrimSpace(chunk.Text) != "" {
+			chunks = append(chunks, chunk)
+		}
+
+		start = next
+	}
+
+	return chunks
+}
+
+// findCut returns the end of the chunk starting at start and
+// the start of the next chunk. The separator between them is dropped.
+func findCut(u []uint16, entities []types.MessageEntity, start, limit int) (int, int) {
+	inside := func(pos int) bool {
+		for _, e := range entities {
+			if e.Offset < pos && pos < e.Offset+e.Length {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	lastSeparator := func(checkEntities bool) (int, int, bool) {
+		for _, sep := range separators {
+			for pos := start + limit; pos > start; pos-- {
+				if pos+len(sep) > len(u) || !slices.Equal(u[pos:pos+len(sep)], sep) {
+					continue
+				}
+
+				if checkEntities && (inside(pos) || inside(pos+len(sep))) {
+					continue
+				}
+
+				return pos, pos + len(sep), true
+			}
+		}
+
+		return 0, 0, false
+	}
+
+	if end, next, ok := lastSeparator(true); ok {
+		return end, next
+	}
+
+	for pos := start + limit; pos > start; pos-- {
+		if !inside(pos) && !isHighSurrogate(u[pos-1]) {
+			return pos, pos
+		}
+	}
+
+	// An entity is longer than the limit and has to be cut.
+	if end, next, ok := lastSeparator(false); ok {
+		return end, next
+	}
+
+	end := start + limit
+	if isHighSurrogate(u[end-1]) && end-1 > start {
+		end--
+	}
+
+	return end, end
+}
+
+// clipEntities returns the parts of the entities
+// inside [start, end) relative to start.
+func clipEntities(entities []types.MessageEntity, start, end int) []types.MessageEntity {
+	var result []types.MessageEntity
+
+	for _, e := range entities {
+		entityStart := max(e.Offset, start)
+		entityEnd := min(e.Offset+e.Length, end)
+
+		if entityEnd <= entityStart {
+			continue
+		}
+
+		e.Offset = entityStart - start
+		e.Length = entityEnd - entityStart
+		result = append(result, e)
+	}
+
+	return result
+}
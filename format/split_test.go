@@ -0,0 +1,141 @@
+package format
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+func TestEntityText(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		entity types.MessageEntity
+		want   string
+	}{
+		{"ascii", "hi @user", types.MessageEntity{Offset: 3, Length: 5}, "@user"},
+		{"after emoji", "👍👍 @user", types.MessageEntity{Offset: 5, Length: 5}, "@user"},
+		{"emoji inside", "a #tag😀 b", types.MessageEntity{Offset: 2, Length: 6}, "#tag😀"},
+		{"out of range", "abc", types.MessageEntity{Offset: 2, Length: 10}, "c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EntityText(tt.text, tt.entity); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUTF16Len(t *testing.T) {
+	tests := map[string]int{
+		"":     0,
+		"abc":  3,
+		"é":    1,
+		"😀":    2,
+		"a😀b👍": 6,
+	}
+
+	for text, want := range tests {
+		if got := UTF16Len(text); got != want {
+			t.Errorf("UTF16Len(%q) = %d, want %d", text, got, want)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		entities []types.MessageEntity
+		limit    int
+		want     []Chunk
+	}{
+		{
+			"short",
+			"hello", nil, 10,
+			[]Chunk{{Text: "hello"}},
+		},
+		{
+			"paragraphs first",
+			"aaa bbb\n\nccc", nil, 10,
+			[]Chunk{{Text: "aaa bbb"}, {Text: "ccc"}},
+		},
+		{
+			"words",
+			"aaa bbb ccc", nil, 8,
+			[]Chunk{{Text: "aaa bbb"}, {Text: "ccc"}},
+		},
+		{
+			"entity kept whole",
+			"aa bb cc",
+			[]types.MessageEntity{{Type: types.EntityTypeBold, Offset: 3, Length: 5}},
+			6,
+			[]Chunk{
+				{Text: "aa"},
+				{Text: "bb cc", Entities: []types.MessageEntity{{Type: types.EntityTypeBold, Offset: 0, Length: 5}}},
+			},
+		},
+		{
+			"long entity cut",
+			"aaaa bbbb",
+			[]types.MessageEntity{{Type: types.EntityTypeItalic, Offset: 0, Length: 9}},
+			5,
+			[]Chunk{
+				{Text: "aaaa", Entities: []types.MessageEntity{{Type: types.EntityTypeItalic, Offset: 0, Length: 4}}},
+				{Text: "bbbb", Entities: []types.MessageEntity{{Type: types.EntityTypeItalic, Offset: 0, Length: 4}}},
+			},
+		},
+		{
+			"surrogate pair not cut",
+			"a😀😀", nil, 2,
+			[]Chunk{{Text: "a"}, {Text: "😀"}, {Text: "😀"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split(tt.text, tt.entities, tt.limit)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitLimit(t *testing.T) {
+	text := strings.Repeat("😀 word ", 1000)
+
+	for _, chunk := range Split(text, nil, MaxMessageLength) {
+		if n := UTF16Len(chunk.Text); n > MaxMessageLength {
+			t.Errorf("chunk of %d UTF-16 code units exceeds the limit", n)
+		}
+	}
+}
+
+func TestSplitCaption(t *testing.T) {
+	text := strings.Repeat("😀 word ", 1000)
+
+	chunks := SplitCaption(text, nil)
+	if len(chunks) < 2 {
+		t.Fatalf("got %d chunks, want more than one", len(chunks))
+	}
+
+	for i, chunk := range chunks {
+		limit := MaxMessageLength
+		if i == 0 {
+			limit = MaxCaptionLength
+		}
+
+		if n := UTF16Len(chunk.Text); n > limit {
+			t.Errorf("chunk %d of %d UTF-16 code units exceeds the limit %d", i, n, limit)
+		}
+	}
+
+	if n := UTF16Len(chunks[1].Text); n <= MaxCaptionLength {
+		t.Errorf("second chunk of %d UTF-16 code units uses the caption limit", n)
+	}
+}
@@ -0,0 +1,29 @@
+package format
+
+// Telegram measures entity offsets and lengths, as well as
+// message length limits, in UTF-16 code units.
+
+// UTF16Len returns the length of the text in UTF-16 code units.
+func UTF16Len(text string) int {
+	n := 0
+
+	for _, r := range text {
+		n += runeUTF16Len(r)
+	}
+
+	return n
+}
+
+func runeUTF16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+
+	return 1
+}
+
+// isHighSurrogate reports whether the code unit starts a surrogate
+// pair, so text can't be cut right after it.
+func isHighSurrogate(unit uint16) bool {
+	return unit >= 0xd800 && unit < 0xdc00
+}
@@ -0,0 +1,162 @@
+// Package keyboard provides builders for inline and reply keyboards.
+//
+//	markup, err := keyboard.NewInline().
+//		Callback("Yes", "answer:yes").
+//		Callback("No", "answer:no").
+//		Row().
+//		URL("Docs", "https://core.telegram.org/bots/api").
+//		Build()
+package keyboard
+
+import (
+	"fmt"
+
+	"github.com/purkhanov/gogram/callbackdata"
+	"github.com/purkhanov/gogram/types"
+)
+
+type InlineBuilder struct {
+	layout layout[types.InlineKeyboardButton]
+}
+
+func NewInline() *InlineBuilder {
+	return &InlineBuilder{}
+}
+
+// InlineFromItems makes a button for every item and lays
+// the buttons out with the given sizes (see Adjust).
+func InlineFromItems[T any](
+	items []T, button func(T) types.InlineKeyboardButton, sizes ...int,
+) *InlineBuilder {
+	b := NewInline()
+
+	for _, item := range items {
+		b.Button(button(item))
+	}
+
+	return b.Adjust(sizes...)
+}
+
+// Button adds buttons to the current row.
+func (b *InlineBuilder) Button(buttons ...types.InlineKeyboardButton) *InlineBuilder {
+	b.layout.add(buttons...)
+	return b
+}
+
+// Row closes the current row. If buttons are
+// given, they are added as a separate row.
+func (b *InlineBuilder) Row(buttons ...types.InlineKeyboardButton) *InlineBuilder {
+	b.layout.row(buttons...)
+	return b
+}
+
+func (b *InlineBuilder) Callback(text, data string) *InlineBuilder {
+	return b.Button(CallbackButton(text, data))
+}
+
+func (b *InlineBuilder) URL(text, url string) *InlineBuilder {
+	return b.Button(URLButton(text, url))
+}
+
+func (b *InlineBuilder) WebApp(text, url string) *InlineBuilder {
+	return b.Button(WebAppButton(text, url))
+}
+
+// Pay adds a Pay button. It must be the first button of
+// the keyboard and can only be used in invoice messages.
+func (b *InlineBuilder) Pay(text string) *InlineBuilder {
+	return b.Button(PayButton(text))
+}
+
+// Adjust lays out all buttons added so far into rows of the
+// given sizes, the last size is repeated for the remaining
+// buttons. For example Adjust(2, 3, 1) on eight buttons
+// makes rows of 2, 3, 1, 1 and 1 buttons.
+func (b *InlineBuilder) Adjust(sizes ...int) *InlineBuilder {
+	b.layout.adjust(sizes...)
+	return b
+}
+
+// Build validates the buttons and returns the keyboard.
+func (b *InlineBuilder) Build() (types.InlineKeyboardMarkup, error) {
+	rows := b.layout.markup()
+
+	for i, row := range rows {
+		for j, button := range row {
+			if err := validateInlineButton(button, i == 0 && j == 0); err != nil {
+				return types.InlineKeyboardMarkup{}, fmt.Errorf(
+					"button %q (row %d, column %d): %w", button.Text, i+1, j+1, err,
+				)
+			}
+		}
+	}
+
+	return types.InlineKeyboardMarkup{InlineKeyboard: rows}, nil
+}
+
+// MustBuild is like Build but panics on error.
+func (b *InlineBuilder) MustBuild() types.InlineKeyboardMarkup {
+	markup, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	return markup
+}
+
+func CallbackButton(text, data string) types.InlineKeyboardButton {
+	return types.InlineKeyboardButton{Text: text, CallbackData: data}
+}
+
+func URLButton(text, url string) types.InlineKeyboardButton {
+	return types.InlineKeyboardButton{Text: text, Url: url}
+}
+
+func WebAppButton(text, url string) types.InlineKeyboardButton {
+	return types.InlineKeyboardButton{Text: text, WebApp: &types.WebAppInfo{Url: url}}
+}
+
+func PayButton(text string) types.InlineKeyboardButton {
+	return types.InlineKeyboardButton{Text: text, Pay: true}
+}
+
+func validateInlineButton(button types.InlineKeyboardButton, first bool) error {
+	if button.Text == "" {
+		return fmt.Errorf("text is required")
+	}
+
+	actions := 0
+	for _, set := range []bool{
+		button.Url != "",
+		button.CallbackData != "",
+		button.WebApp != nil,
+		button.LoginUrl != nil,
+		button.SwitchInlineQuery != "",
+		button.SwitchInlineQueryCurrentChat != "",
+		button.SwitchInlineQueryChosenChat != nil,
+		button.CopyText != nil,
+		button.CallbackGame != nil,
+		button.Pay,
+	} {
+		if set {
+			actions++
+		}
+	}
+
+	if actions != 1 {
+		return fmt.Errorf("exactly one action must be set, got %d", actions)
+	}
+
+	if len(button.CallbackData) > callbackdata.MaxSize {
+		return fmt.Errorf(
+			"callback data is %d bytes, at most %d allowed",
+			len(button.CallbackData), callbackdata.MaxSize,
+		)
+	}
+
+	if (button.Pay || button.CallbackGame != nil) && !first {
+		return fmt.Errorf("pay and game buttons must be the first button in the first row")
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package keyboard
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+// shape returns the number of buttons in each row.
+func shape(rows [][]types.InlineKeyboardButton) []int {
+	var sizes []int
+	for _, row := range rows {
+		sizes = append(sizes, len(row))
+	}
+
+	return sizes
+}
+
+func buttons(n int) []types.InlineKeyboardButton {
+	var result []types.InlineKeyboardButton
+	for i := range n {
+		result = append(result, CallbackButton(strconv.Itoa(i), strconv.Itoa(i)))
+	}
+
+	return result
+}
+
+func TestLayout(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(b *InlineBuilder)
+		want  []int
+	}{
+		{
+			"one row",
+			func(b *InlineBuilder) { b.Button(buttons(3)...) },
+			[]int{3},
+		},
+		{
+			"grid",
+			func(b *InlineBuilder) { b.Button(buttons(5)...).Adjust(2) },
+			[]int{2, 2, 1},
+		},
+		{
+			"last size repeated",
+			func(b *InlineBuilder) { b.Button(buttons(8)...).Adjust(2, 3, 1) },
+			[]int{2, 3, 1, 1, 1},
+		},
+		{
+			"size over the count",
+			func(b *InlineBuilder) { b.Button(buttons(3)...).Adjust(5) },
+			[]int{3},
+		},
+		{
+			"rows",
+			func(b *InlineBuilder) { b.Button(buttons(2)...).Row().Button(buttons(1)...) },
+			[]int{2, 1},
+		},
+		{
+			"separate row",
+			func(b *InlineBuilder) { b.Button(buttons(1)...).Row(buttons(2)...).Button(buttons(1)...) },
+			[]int{1, 2, 1},
+		},
+		{
+			"row on an empty keyboard",
+			func(b *InlineBuilder) { b.Row().Button(buttons(2)...) },
+			[]int{2},
+		},
+		{
+			"buttons after adjust",
+			func(b *InlineBuilder) { b.Button(buttons(3)...).Adjust(2).Button(buttons(1)...) },
+			[]int{2, 1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewInline()
+			tt.build(b)
+
+			markup, err := b.Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := shape(markup.InlineKeyboard); !slices.Equal(got, tt.want) {
+				t.Errorf("rows = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRowCopiesButtons(t *testing.T) {
+	row := buttons(2)
+
+	b := NewInline().Row(row...)
+	row[0] = CallbackButton("changed", "changed")
+
+	markup := b.MustBuild()
+	if got := markup.InlineKeyboard[0][0].Text; got != "0" {
+		t.Errorf("button text = %q, want %q", got, "0")
+	}
+}
+
+func TestBuildValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		buttons []types.InlineKeyboardButton
+		wantErr bool
+	}{
+		{"callback", []types.InlineKeyboardButton{CallbackButton("a", "b")}, false},
+		{"no text", []types.InlineKeyboardButton{CallbackButton("", "b")}, true},
+		{"no action", []types.InlineKeyboardButton{{Text: "a"}}, true},
+		{"two actions", []types.InlineKeyboardButton{{Text: "a", CallbackData: "b", Url: "https://x.y"}}, true},
+		{"long callback data", []types.InlineKeyboardButton{CallbackButton("a", strings.Repeat("b", 65))}, true},
+		{"pay first", []types.InlineKeyboardButton{PayButton("pay"), CallbackButton("a", "b")}, false},
+		{"pay second", []types.InlineKeyboardButton{CallbackButton("a", "b"), PayButton("pay")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewInline().Button(tt.buttons...).Build()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package keyboard
+
+import "slices"
+
+// layout keeps buttons of any kind in rows. The last row stays
+// open for new buttons until it is closed with closeRow.
+type layout[B any] struct {
+	rows   [][]B
+	closed bool
+}
+
+func (l *layout[B]) add(buttons ...B) {
+	if len(l.rows) == 0 || l.closed {
+		l.rows = append(l.rows, nil)
+		l.closed = false
+	}
+
+	last := len(l.rows) - 1
+	l.rows[last] = append(l.rows[last], buttons...)
+}
+
+func (l *layout[B]) row(buttons ...B) {
+	l.closeRow()
+
+	if len(buttons) > 0 {
+		// The caller may reuse the slice, so the row gets its own copy
+		l.rows = append(l.rows, slices.Clone(buttons))
+	}
+}
+
+func (l *layout[B]) closeRow() {
+	l.closed = true
+}
+
+// adjust lays out all buttons added so far into rows of the given
+// sizes. The last size is repeated for the remaining buttons, so
+// adjust(2) makes a grid of two columns and adjust(2, 3, 1) makes
+// rows of 2, 3, 1, 1, ... buttons.
+func (l *layout[B]) adjust(sizes ...int) {
+	if len(sizes) == 0 {
+		return
+	}
+
+	var buttons []B
+	for _, row := range l.rows {
+		buttons = append(buttons, row...)
+	}
+
+	l.rows = nil
+	l.closed = false
+
+	for i := 0; len(buttons) > 0; i++ {
+		size := sizes[min(i, len(sizes)-1)]
+		if size <= 0 || size > len(buttons) {
+			size = len(buttons)
+		}
+
+		l.rows = append(l.rows, buttons[:size:size])
+		buttons = buttons[size:]
+	}
+
+	l.closeRow()
+}
+
+func (l *layout[B]) markup() [][]B {
+	rows := make([][]B, 0, len(l.rows))
+
+	for _, row := range l.rows {
+		if len(row) > 0 {
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,157 @@
+package keyboard
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/purkhanov/gogram/types"
+)
+
+const maxPlaceholderLength = 64
+
+type ReplyBuilder struct {
+	layout layout[types.KeyboardButton]
+	markup types.ReplyKeyboardMarkup
+}
+
+func NewReply() *ReplyBuilder {
+	return &ReplyBuilder{}
+}
+
+// ReplyFromItems makes a text button for every item and
+// lays the buttons out with the given sizes (see Adjust).
+func ReplyFromItems[T any](items []T, text func(T) string, sizes ...int) *ReplyBuilder {
+	b := NewReply()
+
+	for _, item := range items {
+		b.Text(text(item))
+	}
+
+	return b.Adjust(sizes...)
+}
+
+// Button adds buttons to the current row.
+func (b *ReplyBuilder) Button(buttons ...types.KeyboardButton) *ReplyBuilder {
+	b.layout.add(buttons...)
+	return b
+}
+
+// Row closes the current row. If buttons are
+// given, they are added as a separate row.
+func (b *ReplyBuilder) Row(buttons ...types.KeyboardButton) *ReplyBuilder {
+	b.layout.row(buttons...)
+	return b
+}
+
+// Text adds a button that sends its text when pressed.
+func (b *ReplyBuilder) Text(text string) *ReplyBuilder {
+	return b.Button(types.KeyboardButton{Text: text})
+}
+
+// Contact adds a button that sends the user's phone number.
+func (b *ReplyBuilder) Contact(text string) *ReplyBuilder {
+	return b.Button(types.KeyboardButton{Text: text, RequestContact: true})
+}
+
+// Location adds a button that sends the user's current location.
+func (b *ReplyBuilder) Location(text string) *ReplyBuilder {
+	return b.Button(types.KeyboardButton{Text: text, RequestLocation: true})
+}
+
+func (b *ReplyBuilder) WebApp(text, url string) *ReplyBuilder {
+	return b.Button(types.KeyboardButton{Text: text, WebApp: &types.WebAppInfo{Url: url}})
+}
+
+// Adjust lays out all buttons added so far into rows of the
+// given sizes, the last size is repeated for the remaining buttons.
+func (b *ReplyBuilder) Adjust(sizes ...int) *ReplyBuilder {
+	b.layout.adjust(sizes...)
+	return b
+}
+
+func (b *ReplyBuilder) Resize() *ReplyBuilder {
+	b.markup.ResizeKeyboard = true
+	return b
+}
+
+func (b *ReplyBuilder) OneTime() *ReplyBuilder {
+	b.markup.OneTimeKeyboard = true
+	return b
+}
+
+func (b *ReplyBuilder) Persistent() *ReplyBuilder {
+	b.markup.IsPersistent = true
+	return b
+}
+
+func (b *ReplyBuilder) Selective() *ReplyBuilder {
+	b.markup.Selective = true
+	return b
+}
+
+func (b *ReplyBuilder) Placeholder(text string) *ReplyBuilder {
+	b.markup.InputFieldPlaceholder = text
+	return b
+}
+
+// Build validates the buttons and returns the keyboard.
+func (b *ReplyBuilder) Build() (types.ReplyKeyboardMarkup, error) {
+	if utf8.RuneCountInString(b.markup.InputFieldPlaceholder) > maxPlaceholderLength {
+		return types.ReplyKeyboardMarkup{}, fmt.Errorf(
+			"placeholder must be at most %d characters", maxPlaceholderLength,
+		)
+	}
+
+	rows := b.layout.markup()
+
+	for i, row := range rows {
+		for j, button := range row {
+			if err := validateReplyButton(button); err != nil {
+				return types.ReplyKeyboardMarkup{}, fmt.Errorf(
+					"button %q (row %d, column %d): %w", button.Text, i+1, j+1, err,
+				)
+			}
+		}
+	}
+
+	markup := b.markup
+	markup.Keyboard = rows
+
+	return markup, nil
+}
+
+// MustBuild is like Build but panics on error.
+func (b *ReplyBuilder) MustBuild() types.ReplyKeyboardMarkup {
+	markup, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	return markup
+}
+
+func validateReplyButton(button types.KeyboardButton) error {
+	if button.Text == "" {
+		return fmt.Errorf("text is required")
+	}
+
+	actions := 0
+	for _, set := range []bool{
+		button.RequestUsers != nil,
+		button.RequestChat != nil,
+		button.RequestContact,
+		button.RequestLocation,
+		button.RequestPoll != nil,
+		button.WebApp != nil,
+	} {
+		if set {
+			actions++
+		}
+	}
+
+	if actions > 1 {
+		return fmt.Errorf("at most one action can be set, got %d", actions)
+	}
+
+	return nil
+}
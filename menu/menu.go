@@ -0,0 +1,212 @@
+// Package menu provides paginated inline menus. A menu renders a
+// page of items as inline buttons with navigation buttons below
+// and flips pages itself when the navigation buttons are pressed.
+//
+//	products := menu.New(d, menu.Config[Product]{
+//		ID:    "products",
+//		Items: loadProducts,
+//		Button: func(p Product) types.InlineKeyboardButton {
+//			return keyboard.CallbackButton(p.Name, "product:"+p.ID)
+//		},
+//	})
+//
+//	products.Send(chatID, 0)
+package menu
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/purkhanov/gogram/api"
+	"github.com/purkhanov/gogram/bot"
+	"github.com/purkhanov/gogram/callbackdata"
+	"github.com/purkhanov/gogram/dispatcher"
+	"github.com/purkhanov/gogram/keyboard"
+	"github.com/purkhanov/gogram/types"
+)
+
+const (
+	defaultPageSize = 5
+	defaultColumns  = 1
+	defaultPrevText = "«"
+	defaultNextText = "»"
+
+	// counterPage marks the page counter button, which does nothing.
+	counterPage = -1
+)
+
+type Config[T any] struct {
+	// Callback data prefix of the navigation
+	// buttons, must be unique for every menu
+	ID string
+
+	// Returns the items to show. It is called every
+	// time a page is rendered, so the list may change.
+	Items func() []T
+
+	// Makes a button for an item
+	Button func(T) types.InlineKeyboardButton
+
+	// Optional. Text of the menu message.
+	// Defaults to “Page N of M”.
+	Text func(page, pages int) string
+
+	// Optional. Number of items on a page. Defaults to 5.
+	PageSize int
+
+	// Optional. Number of item buttons in a row. Defaults to 1.
+	Columns int
+
+	// Optional. Labels of the navigation buttons. Default to “«” and “»”.
+	PrevText string
+	NextText string
+}
+
+type Menu[T any] struct {
+	bot    *bot.Bot
+	config Config[T]
+	data   *callbackdata.CallbackData[pageData]
+}
+
+type pageData struct {
+	Page int
+}
+
+// New creates a menu and registers its callback query handler in the dispatcher.
+func New[T any](d *dispatcher.Dispatcher, config Config[T]) *Menu[T] {
+	if config.PageSize <= 0 {
+		config.PageSize = defaultPageSize
+	}
+
+	if config.Columns <= 0 {
+		config.Columns = defaultColumns
+	}
+
+	if config.PrevText == "" {
+		config.PrevText = defaultPrevText
+	}
+
+	if config.NextText == "" {
+		config.NextText = defaultNextText
+	}
+
+	if config.Text == nil {
+		config.Text = func(page, pages int) string {
+			return fmt.Sprintf("Page %d of %d", page+1, pages)
+		}
+	}
+
+	m := &Menu[T]{
+		bot:    d.Bot,
+		config: config,
+		data:   callbackdata.New[pageData](config.ID),
+	}
+
+	d.OnCallbackQuery(m.data.Handler(m.handleCallbackQuery), m.data.Filter())
+
+	return m
+}
+
+// Pages returns the number of pages, at least one.
+func (m *Menu[T]) Pages() int {
+	return m.pages(len(m.config.Items()))
+}
+
+// Markup renders the given zero-based page. Pages out of range are clamped.
+func (m *Menu[T]) Markup(page int) (types.InlineKeyboardMarkup, error) {
+	markup, _, _, err := m.render(page)
+	return markup, err
+}
+
+// Send sends the menu message opened on the given page.
+func (m *Menu[T]) Send(chatID types.ChatID, page int) (types.Message, error) {
+	markup, page, pages, err := m.render(page)
+	if err != nil {
+		return types.Message{}, err
+	}
+
+	return m.bot.SendMessage(bot.SendMessageOptions{
+		ChatID:      chatID,
+		Text:        m.config.Text(page, pages),
+		ReplyMarkup: markup,
+	})
+}
+
+func (m *Menu[T]) render(page int) (types.InlineKeyboardMarkup, int, int, error) {
+	items := m.config.Items()
+	pages := m.pages(len(items))
+	page = max(0, min(page, pages-1))
+
+	start := page * m.config.PageSize
+	end := min(start+m.config.PageSize, len(items))
+
+	kb := keyboard.InlineFromItems(items[start:end], m.config.Button, m.config.Columns)
+
+	if pages > 1 {
+		var nav []types.InlineKeyboardButton
+
+		if page > 0 {
+			nav = append(nav, m.navButton(m.config.PrevText, page-1))
+		}
+
+		nav = append(nav, m.navButton(fmt.Sprintf("%d/%d", page+1, pages), counterPage))
+
+		if page < pages-1 {
+			nav = append(nav, m.navButton(m.config.NextText, page+1))
+		}
+
+		kb.Row(nav...)
+	}
+
+	markup, err := kb.Build()
+
+	return markup, page, pages, err
+}
+
+func (m *Menu[T]) navButton(text string, page int) types.InlineKeyboardButton {
+	return keyboard.CallbackButton(text, m.data.MustPack(pageData{Page: page}))
+}
+
+func (m *Menu[T]) pages(count int) int {
+	return max(1, (count+m.config.PageSize-1)/m.config.PageSize)
+}
+
+func (m *Menu[T]) handleCallbackQuery(cb *types.CallbackQuery, data pageData) {
+	answer := bot.AnswerCallbackQueryOptions{CallbackQueryID: cb.ID}
+
+	defer func() {
+		if err := m.bot.AnswerCallbackQuery(answer); err != nil {
+			log.Printf("menu %s: failed to answer callback query: %v", m.config.ID, err)
+		}
+	}()
+
+	if data.Page == counterPage {
+		return
+	}
+
+	target, ok := bot.CallbackTarget(cb)
+	if !ok {
+		return
+	}
+
+	markup, page, pages, err := m.render(data.Page)
+	if err != nil {
+		log.Printf("menu %s: failed to render page: %v", m.config.ID, err)
+		return
+	}
+
+	_, err = m.bot.EditMessageText(bot.EditMessageTextOptions{
+		MessageTarget: target,
+		Text:          m.config.Text(page, pages),
+		ReplyMarkup:   &markup,
+	})
+
+	switch {
+	case err == nil, api.IsMessageNotModified(err):
+	case api.IsMessageNotEditable(err):
+		answer.Text = "This menu is no longer available."
+		answer.ShowAlert = true
+	default:
+		log.Printf("menu %s: failed to edit message: %v", m.config.ID, err)
+	}
+}
@@ -0,0 +1,71 @@
+package tally
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+// Storage keeps the answers given in polls.
+type Storage interface {
+	// SetAnswer replaces the options chosen by the voter.
+	// Empty optionIDs means the vote was retracted.
+	SetAnswer(pollID string, voterID int64, optionIDs []int) error
+
+	// Answers returns the options chosen by each voter of the poll.
+	Answers(pollID string) (map[int64][]int, error)
+
+	// Delete removes all answers given in the poll.
+	Delete(pollID string) error
+}
+
+// MemoryStorage is a Storage that keeps answers in memory.
+// Stored answers are lost when the process restarts.
+type MemoryStorage struct {
+	mu    sync.RWMutex
+	polls map[string]map[int64][]int
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{polls: make(map[string]map[int64][]int)}
+}
+
+func (s *MemoryStorage) SetAnswer(pollID string, voterID int64, optionIDs []int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	answers := s.polls[pollID]
+
+	if len(optionIDs) == 0 {
+		delete(answers, voterID)
+
+		if len(answers) == 0 {
+			delete(s.polls, pollID)
+		}
+
+		return nil
+	}
+
+	if answers == nil {
+		answers = make(map[int64][]int)
+		s.polls[pollID] = answers
+	}
+
+	answers[voterID] = slices.Clone(optionIDs)
+	return nil
+}
+
+func (s *MemoryStorage) Answers(pollID string) (map[int64][]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return maps.Clone(s.polls[pollID]), nil
+}
+
+func (s *MemoryStorage) Delete(pollID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.polls, pollID)
+	return nil
+}
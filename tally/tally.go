@@ -0,0 +1,108 @@
+// Package tally aggregates the answers given in non-anonymous polls
+// sent by the bot. Telegram only reports the number of votes per
+// option, a tally also keeps who voted for what.
+//
+//	t := tally.New(d, nil)
+//	results, err := t.Results(pollID)
+//
+// Answers are kept until Forget is called for the poll.
+package tally
+
+import (
+	"log"
+	"slices"
+
+	"github.com/purkhanov/gogram/dispatcher"
+	"github.com/purkhanov/gogram/types"
+)
+
+type Tally struct {
+	storage Storage
+}
+
+// Results of a poll.
+type Results struct {
+	PollID string
+
+	// Identifiers of the voters that chose each option
+	Votes map[int][]int64
+
+	// Number of voters that answered the poll
+	Voters int
+}
+
+// Count returns the number of votes for the option.
+func (r Results) Count(optionID int) int {
+	return len(r.Votes[optionID])
+}
+
+// New registers a poll answer handler that records the answers
+// in the storage. A nil storage keeps answers in memory.
+func New(d *dispatcher.Dispatcher, storage Storage) *Tally {
+	if storage == nil {
+		storage = NewMemoryStorage()
+	}
+
+	t := &Tally{storage: storage}
+
+	d.OnPollAnswer(t.handleAnswer)
+
+	return t
+}
+
+// Forget removes the answers given in the poll. Answers are kept
+// until then, so call it once the results of the poll are no longer
+// needed, e.g. after handling its closing in OnPoll.
+func (t *Tally) Forget(pollID string) error {
+	return t.storage.Delete(pollID)
+}
+
+// Results returns the answers given in the poll so far.
+func (t *Tally) Results(pollID string) (Results, error) {
+	answers, err := t.storage.Answers(pollID)
+	if err != nil {
+		return Results{}, err
+	}
+
+	results := Results{
+		PollID: pollID,
+		Votes:  make(map[int][]int64),
+		Voters: len(answers),
+	}
+
+	for voterID, optionIDs := range answers {
+		for _, optionID := range optionIDs {
+			results.Votes[optionID] = append(results.Votes[optionID], voterID)
+		}
+	}
+
+	for _, voters := range results.Votes {
+		slices.Sort(voters)
+	}
+
+	return results, nil
+}
+
+func (t *Tally) handleAnswer(answer *types.PollAnswer) {
+	voterID, ok := voter(answer)
+	if !ok {
+		return
+	}
+
+	if err := t.storage.SetAnswer(answer.PollID, voterID, answer.OptionIDs); err != nil {
+		log.Println("failed to save poll answer:", err)
+	}
+}
+
+// voter returns the identifier of the user or
+// the chat that answered the poll.
+func voter(answer *types.PollAnswer) (int64, bool) {
+	switch {
+	case answer.User != nil:
+		return answer.User.ID, true
+	case answer.VoterChat != nil:
+		return answer.VoterChat.ID, true
+	default:
+		return 0, false
+	}
+}
@@ -1,11 +1,28 @@
 package types
 
-// This object describes the way a background
-// is filled based on the selected colors.
+import "encoding/json"
+
+const (
+	BackgroundFillTypeSolid            = "solid"
+	BackgroundFillTypeGradient         = "gradient"
+	BackgroundFillTypeFreeformGradient = "freeform_gradient"
+
+	BackgroundTypeTypeFill      = "fill"
+	BackgroundTypeTypeWallpaper = "wallpaper"
+	BackgroundTypeTypePattern   = "pattern"
+	BackgroundTypeTypeChatTheme = "chat_theme"
+)
+
+// This object describes the way a background is filled
+// based on the selected colors. Exactly one of the fields is set.
 type BackgroundFill struct {
-	// BackgroundFillSolid
-	// BackgroundFillGradient
-	// BackgroundFillFreeformGradient
+	Solid            *BackgroundFillSolid
+	Gradient         *BackgroundFillGradient
+	FreeformGradient *BackgroundFillFreeformGradient
+
+	// Optional. Raw JSON of a variant unknown
+	// to this version of the library
+	Unknown json.RawMessage
 }
 
 // The background is filled using the selected color.
@@ -43,13 +60,17 @@ type BackgroundFillFreeformGradient struct {
 	Colors []int `json:"colors"`
 }
 
-// This object describes the type of a
-// background. Currently, it can be one of
+// This object describes the type of a background.
+// Exactly one of the fields is set.
 type BackgroundType struct {
-	// BackgroundTypeFill
-	// BackgroundTypeWallpaper
-	// BackgroundTypePattern
-	// BackgroundTypeChatTheme
+	Fill      *BackgroundTypeFill
+	Wallpaper *BackgroundTypeWallpaper
+	Pattern   *BackgroundTypePattern
+	ChatTheme *BackgroundTypeChatTheme
+
+	// Optional. Raw JSON of a variant unknown
+	// to this version of the library
+	Unknown json.RawMessage
 }
 
 // The background is automatically filled based on the selected colors.
@@ -120,3 +141,79 @@ type BackgroundTypeChatTheme struct {
 	// Name of the chat theme, which is usually an emoji
 	ThemeName string `json:"theme_name"`
 }
+
+func (f *BackgroundFill) UnmarshalJSON(data []byte) error {
+	kind, err := unionKind(data, "type")
+	if err != nil {
+		return err
+	}
+
+	*f = BackgroundFill{}
+
+	switch kind {
+	case BackgroundFillTypeSolid:
+		return unmarshalVariant(data, &f.Solid)
+	case BackgroundFillTypeGradient:
+		return unmarshalVariant(data, &f.Gradient)
+	case BackgroundFillTypeFreeformGradient:
+		return unmarshalVariant(data, &f.FreeformGradient)
+	default:
+		f.Unknown = unknownVariant(data)
+		return nil
+	}
+}
+
+func (f BackgroundFill) MarshalJSON() ([]byte, error) {
+	switch {
+	case f.Solid != nil:
+		return json.Marshal(f.Solid)
+	case f.Gradient != nil:
+		return json.Marshal(f.Gradient)
+	case f.FreeformGradient != nil:
+		return json.Marshal(f.FreeformGradient)
+	case f.Unknown != nil:
+		return f.Unknown, nil
+	default:
+		return []byte("null"), nil
+	}
+}
+
+func (t *BackgroundType) UnmarshalJSON(data []byte) error {
+	kind, err := unionKind(data, "type")
+	if err != nil {
+		return err
+	}
+
+	*t = BackgroundType{}
+
+	switch kind {
+	case BackgroundTypeTypeFill:
+		return unmarshalVariant(data, &t.Fill)
+	case BackgroundTypeTypeWallpaper:
+		return unmarshalVariant(data, &t.Wallpaper)
+	case BackgroundTypeTypePattern:
+		return unmarshalVariant(data, &t.Pattern)
+	case BackgroundTypeTypeChatTheme:
+		return unmarshalVariant(data, &t.ChatTheme)
+	default:
+		t.Unknown = unknownVariant(data)
+		return nil
+	}
+}
+
+func (t BackgroundType) MarshalJSON() ([]byte, error) {
+	switch {
+	case t.Fill != nil:
+		return json.Marshal(t.Fill)
+	case t.Wallpaper != nil:
+		return json.Marshal(t.Wallpaper)
+	case t.Pattern != nil:
+		return json.Marshal(t.Pattern)
+	case t.ChatTheme != nil:
+		return json.Marshal(t.ChatTheme)
+	case t.Unknown != nil:
+		return t.Unknown, nil
+	default:
+		return []byte("null"), nil
+	}
+}
@@ -1,5 +1,7 @@
 package types
 
+import "encoding/json"
+
 type ChatBoost struct {
 	// Unique identifier of the boost
 	BoostID string `json:"boost_id"`
@@ -23,8 +25,8 @@ type ChatBoostAdded struct {
 }
 
 type ChatBoostRemoved struct {
-	Chat    Chat `json:"chat"`     // Chat which was boosted
-	BoostID Chat `json:"boost_id"` // Unique identifier of the boost
+	Chat    Chat   `json:"chat"`     // Chat which was boosted
+	BoostID string `json:"boost_id"` // Unique identifier of the boost
 
 	// Point in time (Unix timestamp) when the boost was removed
 	RemoveDate int `json:"remove_date"`
@@ -37,8 +39,103 @@ type ChatBoostUpdated struct {
 	Boost ChatBoost `json:"boost"` // Information about the chat boost
 }
 
+const (
+	ChatBoostSourceTypePremium  = "premium"
+	ChatBoostSourceTypeGiftCode = "gift_code"
+	ChatBoostSourceTypeGiveaway = "giveaway"
+)
+
+// This object describes the source of a chat boost.
+// Exactly one of the fields is set.
 type ChatBoostSource struct {
-	// ChatBoostSourcePremium
-	// ChatBoostSourceGiftCode
-	// ChatBoostSourceGiveaway
+	Premium  *ChatBoostSourcePremium
+	GiftCode *ChatBoostSourceGiftCode
+	Giveaway *ChatBoostSourceGiveaway
+
+	// Optional. Raw JSON of a variant unknown
+	// to this version of the library
+	Unknown json.RawMessage
+}
+
+// The boost was obtained by subscribing to Telegram Premium
+// or by gifting a Telegram Premium subscription to another user.
+type ChatBoostSourcePremium struct {
+	// Source of the boost, always “premium”
+	Source string `json:"source"`
+
+	// User that boosted the chat
+	User User `json:"user"`
+}
+
+// The boost was obtained by the creation of Telegram Premium gift
+// codes to boost a chat. Each such code boosts the chat 4 times
+// for the duration of the corresponding Telegram Premium subscription.
+type ChatBoostSourceGiftCode struct {
+	// Source of the boost, always “gift_code”
+	Source string `json:"source"`
+
+	// User for which the gift code was created
+	User User `json:"user"`
+}
+
+// The boost was obtained by the creation of a Telegram Premium or a
+// Telegram Star giveaway. This boosts the chat 4 times for the duration
+// of the corresponding Telegram Premium subscription for Telegram Premium
+// giveaways and prize_star_count / 500 times for one year for Telegram
+// Star giveaways.
+type ChatBoostSourceGiveaway struct {
+	// Source of the boost, always “giveaway”
+	Source string `json:"source"`
+
+	// Identifier of a message in the chat with the giveaway; the message
+	// could have been deleted already. May be 0 if the message isn't sent yet.
+	GiveawayMessageID int `json:"giveaway_message_id"`
+
+	// Optional. User that won the prize in the giveaway if any;
+	// for Telegram Premium giveaways only
+	User *User `json:"user,omitempty"`
+
+	// Optional. The number of Telegram Stars to be split between
+	// giveaway winners; for Telegram Star giveaways only
+	PrizeStarCount int `json:"prize_star_count,omitempty"`
+
+	// Optional. True, if the giveaway was completed,
+	// but there was no user to win the prize
+	IsUnclaimed bool `json:"is_unclaimed,omitempty"`
+}
+
+func (s *ChatBoostSource) UnmarshalJSON(data []byte) error {
+	kind, err := unionKind(data, "source")
+	if err != nil {
+		return err
+	}
+
+	*s = ChatBoostSource{}
+
+	switch kind {
+	case ChatBoostSourceTypePremium:
+		return unmarshalVariant(data, &s.Premium)
+	case ChatBoostSourceTypeGiftCode:
+		return unmarshalVariant(data, &s.GiftCode)
+	case ChatBoostSourceTypeGiveaway:
+		return unmarshalVariant(data, &s.Giveaway)
+	default:
+		s.Unknown = unknownVariant(data)
+		return nil
+	}
+}
+
+func (s ChatBoostSource) MarshalJSON() ([]byte, error) {
+	switch {
+	case s.Premium != nil:
+		return json.Marshal(s.Premium)
+	case s.GiftCode != nil:
+		return json.Marshal(s.GiftCode)
+	case s.Giveaway != nil:
+		return json.Marshal(s.Giveaway)
+	case s.Unknown != nil:
+		return s.Unknown, nil
+	default:
+		return []byte("null"), nil
+	}
 }
@@ -0,0 +1,19 @@
+package types
+
+// This object represents the bot's name.
+type BotName struct {
+	// The bot's name
+	Name string `json:"name"`
+}
+
+// This object represents the bot's description.
+type BotDescription struct {
+	// The bot's description
+	Description string `json:"description"`
+}
+
+// This object represents the bot's short description.
+type BotShortDescription struct {
+	// The bot's short description
+	ShortDescription string `json:"short_description"`
+}
@@ -65,7 +65,7 @@ type BusinessConnection struct {
 	// defects in interpreting it. But it has at most 52 significant bits,
 	// so a 64-bit integer or double-precision float type are safe for
 	// storing this identifier.
-	UserChatID int `json:"user_chat_id"`
+	UserChatID int64 `json:"user_chat_id"`
 
 	// Date the connection was established in Unix time
 	Date int `json:"date"`
@@ -76,3 +76,60 @@ type BusinessConnection struct {
 	// True, if the connection is active
 	IsEnabled bool `json:"is_enabled"`
 }
+
+// This object is received when messages are
+// deleted from a connected business account.
+type BusinessMessagesDeleted struct {
+	// Unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+
+	// Information about a chat in the business account.
+	// The bot may not have access to the chat or the corresponding user.
+	Chat Chat `json:"chat"`
+
+	// The list of identifiers of deleted messages in the chat of the business account
+	MessageIDs []int `json:"message_ids"`
+}
+
+// Contains information about the start page settings of a Telegram Business account.
+type BusinessIntro struct {
+	// Optional. Title text of the business intro
+	Title string `json:"title,omitempty"`
+
+	// Optional. Message text of the business intro
+	Message string `json:"message,omitempty"`
+
+	// Optional. Sticker of the business intro
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+// Contains information about the location of a Telegram Business account.
+type BusinessLocation struct {
+	// Address of the business
+	Address string `json:"address"`
+
+	// Optional. Location of the business
+	Location *Location `json:"location,omitempty"`
+}
+
+// Describes an interval of time during which a business is open.
+type BusinessOpeningHoursInterval struct {
+	// The minute's sequence number in a week, starting on Monday,
+	// marking the start of the time interval during which the
+	// business is open; 0 - 7 * 24 * 60
+	OpeningMinute int `json:"opening_minute"`
+
+	// The minute's sequence number in a week, starting on Monday,
+	// marking the end of the time interval during which the
+	// business is open; 0 - 8 * 24 * 60
+	ClosingMinute int `json:"closing_minute"`
+}
+
+// Describes the opening hours of a business.
+type BusinessOpeningHours struct {
+	// Unique name of the time zone for which the opening hours are defined
+	TimeZoneName string `json:"time_zone_name"`
+
+	// List of time intervals describing business opening hours
+	OpeningHours []BusinessOpeningHoursInterval `json:"opening_hours"`
+}
@@ -9,7 +9,7 @@ type CallbackQuery struct {
 
 	// Optional. Message sent by the bot with the
 	// callback button that originated the query
-	Message any `json:"message,omitempty"`
+	Message *MaybeInaccessibleMessage `json:"message,omitempty"`
 
 	// Optional. Identifier of the message sent via the bot in
 	// inline mode, that originated the query.
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 type Chat struct {
 	// Unique identifier for this chat. This number may
 	// have more than 32 significant bits and some
@@ -7,7 +9,7 @@ type Chat struct {
 	// defects in interpreting it. But it has at most 52
 	// significant bits, so a signed 64-bit integer or
 	// double-precision float type are safe for storing this identifier.
-	ID uint `json:"id"`
+	ID int64 `json:"id"`
 
 	// Type of the chat, can be either “private”, “group”, “supergroup” or “channel”
 	Type string `json:"type"`
@@ -22,10 +24,13 @@ type Chat struct {
 	FirstName string `json:"first_name,omitempty"`
 
 	// Optional. Last name of the other party in a private chat
-	LastMame string `json:"last_name,omitempty"`
+	LastName string `json:"last_name,omitempty"`
 
 	// Optional. True, if the supergroup chat is a forum (has topics enabled)
-	IsForum string `json:"is_forum,omitempty"`
+	IsForum bool `json:"is_forum,omitempty"`
+
+	// Optional. True, if the chat is the direct messages chat of a channel
+	IsDirectMessages bool `json:"is_direct_messages,omitempty"`
 }
 
 type ChatMemberUpdated struct {
@@ -57,13 +62,11 @@ type ChatMemberUpdated struct {
 	ViaChatFolderInviteLink bool `json:"via_chat_folder_invite_link,omitempty"`
 }
 
-type ChatMember struct{}
-
 type ChatInviteLink struct {
 	// The invite link. If the link was created by another chat
 	// administrator, then the second part of the link will be
 	// replaced with “…”.
-	InviteLink ChatMember `json:"invite_link"`
+	InviteLink string `json:"invite_link"`
 
 	// Creator of the link
 	Creator User `json:"creator"`
@@ -103,6 +106,12 @@ type ChatInviteLink struct {
 	SubscriptionPrice int `json:"subscription_price,omitempty"`
 }
 
+// Expires returns the time when the link will expire
+// or has been expired, or zero time if it never expires.
+func (l ChatInviteLink) Expires() time.Time {
+	return unixTime(l.ExpireDate)
+}
+
 // This object contains information about a chat that was
 // shared with the bot using a KeyboardButtonRequestChat button.
 type ChatShared struct {
@@ -118,7 +127,7 @@ type ChatShared struct {
 	// The bot may not have access to the chat and could
 	// be unable to use this identifier, unless the chat
 	// is already known to the bot by some other means.
-	ChatID int `json:"chat_id"`
+	ChatID int64 `json:"chat_id"`
 
 	// Optional. Title of the chat, if the title was requested by the bot.
 	Title string `json:"title,omitempty"`
@@ -169,13 +178,16 @@ type ChatJoinRequest struct {
 	// safe for storing this identifier. The bot can use this identifier
 	// for 5 minutes to send messages until the join request is processed,
 	// assuming no other administrator contacted the user.
-	UserChatID int `json:"user_chat_id"`
+	UserChatID int64 `json:"user_chat_id"`
 
 	// Date the request was sent in Unix time
 	Date int `json:"date"`
 
 	// Optional. Bio of the user.
-	Bio        string          `json:"bio,omitempty"`
+	Bio string `json:"bio,omitempty"`
+
+	// Optional. Chat invite link that was used
+	// by the user to send the join request
 	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
 }
 
@@ -212,3 +224,20 @@ type VideoChatParticipantsInvited struct {
 	// New members that were invited to the video chat
 	Users []User `json:"users"`
 }
+
+// ChatAction is the type of action to broadcast with sendChatAction.
+type ChatAction string
+
+const (
+	ChatActionTyping          ChatAction = "typing"
+	ChatActionUploadPhoto     ChatAction = "upload_photo"
+	ChatActionRecordVideo     ChatAction = "record_video"
+	ChatActionUploadVideo     ChatAction = "upload_video"
+	ChatActionRecordVoice     ChatAction = "record_voice"
+	ChatActionUploadVoice     ChatAction = "upload_voice"
+	ChatActionUploadDocument  ChatAction = "upload_document"
+	ChatActionChooseSticker   ChatAction = "choose_sticker"
+	ChatActionFindLocation    ChatAction = "find_location"
+	ChatActionRecordVideoNote ChatAction = "record_video_note"
+	ChatActionUploadVideoNote ChatAction = "upload_video_note"
+)
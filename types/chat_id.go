@@ -0,0 +1,79 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// ChatID is a unique identifier for the target chat or the username
+// of the target channel (in the format @channelusername). Identifiers
+// of groups, supergroups and channels are negative, e.g. -1001234567890.
+type ChatID struct {
+	// Unique identifier of the chat. Ignored if Username is set.
+	ID int64
+
+	// Username of the channel, starting with “@”
+	Username string
+}
+
+// NewChatID returns a ChatID for the chat with the given identifier.
+func NewChatID(id int64) ChatID {
+	return ChatID{ID: id}
+}
+
+// ChannelUsername returns a ChatID for the channel with the
+// given username. The leading “@” is added if it is missing.
+func ChannelUsername(username string) ChatID {
+	if !strings.HasPrefix(username, "@") {
+		username = "@" + username
+	}
+
+	return ChatID{Username: username}
+}
+
+// ChatID returns the identifier of the chat to be used as a target.
+func (c Chat) ChatID() ChatID {
+	return NewChatID(c.ID)
+}
+
+// Matches reports whether id refers to the chat,
+// by its identifier or by its username.
+func (c Chat) Matches(id ChatID) bool {
+	if id.Username != "" {
+		return c.Username != "" && strings.EqualFold(id.Username, "@"+c.Username)
+	}
+
+	return c.ChatID() == id
+}
+
+func (c ChatID) IsZero() bool {
+	return c.ID == 0 && c.Username == ""
+}
+
+func (c ChatID) String() string {
+	if c.Username != "" {
+		return c.Username
+	}
+
+	return strconv.FormatInt(c.ID, 10)
+}
+
+func (c ChatID) MarshalJSON() ([]byte, error) {
+	if c.Username != "" {
+		return json.Marshal(c.Username)
+	}
+
+	return []byte(strconv.FormatInt(c.ID, 10)), nil
+}
+
+func (c *ChatID) UnmarshalJSON(data []byte) error {
+	*c = ChatID{}
+
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		return json.Unmarshal(data, &c.Username)
+	}
+
+	return json.Unmarshal(data, &c.ID)
+}
@@ -0,0 +1,28 @@
+package types
+
+import "testing"
+
+func TestChatMatches(t *testing.T) {
+	chat := Chat{ID: -1001, Username: "Channel"}
+
+	tests := []struct {
+		id   ChatID
+		want bool
+	}{
+		{NewChatID(-1001), true},
+		{NewChatID(-1002), false},
+		{ChannelUsername("channel"), true},
+		{ChannelUsername("@Channel"), true},
+		{ChannelUsername("other"), false},
+	}
+
+	for _, tt := range tests {
+		if got := chat.Matches(tt.id); got != tt.want {
+			t.Errorf("Matches(%v) = %t, want %t", tt.id, got, tt.want)
+		}
+	}
+
+	if (Chat{ID: 1}).Matches(ChannelUsername("")) {
+		t.Error("a chat without a username matches an empty username")
+	}
+}
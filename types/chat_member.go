@@ -0,0 +1,471 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	ChatMemberStatusCreator       = "creator"
+	ChatMemberStatusAdministrator = "administrator"
+	ChatMemberStatusMember        = "member"
+	ChatMemberStatusRestricted    = "restricted"
+	ChatMemberStatusLeft          = "left"
+	ChatMemberStatusKicked        = "kicked"
+)
+
+// ChatRight is the name of an administrator right
+// as it appears in ChatAdministratorRights.
+type ChatRight string
+
+const (
+	RightCanManageChat           ChatRight = "can_manage_chat"
+	RightCanDeleteMessages       ChatRight = "can_delete_messages"
+	RightCanManageVideoChats     ChatRight = "can_manage_video_chats"
+	RightCanRestrictMembers      ChatRight = "can_restrict_members"
+	RightCanPromoteMembers       ChatRight = "can_promote_members"
+	RightCanChangeInfo           ChatRight = "can_change_info"
+	RightCanInviteUsers          ChatRight = "can_invite_users"
+	RightCanPostStories          ChatRight = "can_post_stories"
+	RightCanEditStories          ChatRight = "can_edit_stories"
+	RightCanDeleteStories        ChatRight = "can_delete_stories"
+	RightCanPostMessages         ChatRight = "can_post_messages"
+	RightCanEditMessages         ChatRight = "can_edit_messages"
+	RightCanPinMessages          ChatRight = "can_pin_messages"
+	RightCanManageTopics         ChatRight = "can_manage_topics"
+	RightCanManageDirectMessages ChatRight = "can_manage_direct_messages"
+)
+
+// This object contains information about one member of a chat.
+// Exactly one of the fields is set, depending on the member status.
+type ChatMember struct {
+	Owner         *ChatMemberOwner
+	Administrator *ChatMemberAdministrator
+	Member        *ChatMemberMember
+	Restricted    *ChatMemberRestricted
+	Left          *ChatMemberLeft
+	Banned        *ChatMemberBanned
+
+	// Optional. Raw JSON of a variant unknown
+	// to this version of the library
+	Unknown json.RawMessage
+}
+
+// Represents the rights of an administrator in a chat.
+type ChatAdministratorRights struct {
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+
+	// True, if the administrator can access the chat event log,
+	// get boost list, see hidden supergroup and channel members,
+	// report spam messages, ignore slow mode, and send messages
+	// to the chat without paying Telegram Stars. Implied by any
+	// other administrator privilege.
+	CanManageChat bool `json:"can_manage_chat"`
+
+	// True, if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages"`
+
+	// True, if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+
+	// True, if the administrator can restrict, ban or unban
+	// chat members, or access supergroup statistics
+	CanRestrictMembers bool `json:"can_restrict_members"`
+
+	// True, if the administrator can add new administrators
+	// with a subset of their own privileges or demote
+	// administrators that they have promoted, directly
+	// or indirectly (promoted by administrators that
+	// were appointed by the user)
+	CanPromoteMembers bool `json:"can_promote_members"`
+
+	// True, if the user is allowed to change the chat
+	// title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+
+	// True, if the administrator can post stories to the chat
+	CanPostStories bool `json:"can_post_stories"`
+
+	// True, if the administrator can edit stories posted
+	// by other users, post stories to the chat page, pin
+	// chat stories, and access the chat's story archive
+	CanEditStories bool `json:"can_edit_stories"`
+
+	// True, if the administrator can delete stories posted by other users
+	CanDeleteStories bool `json:"can_delete_stories"`
+
+	// Optional. True, if the administrator can post messages
+	// in the channel, approve suggested posts, or access
+	// channel statistics; for channels only
+	CanPostMessages bool `json:"can_post_messages,omitempty"`
+
+	// Optional. True, if the administrator can edit messages
+	// of other users and can pin messages; for channels only
+	CanEditMessages bool `json:"can_edit_messages,omitempty"`
+
+	// Optional. True, if the user is allowed to pin
+	// messages; for groups and supergroups only
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+
+	// Optional. True, if the user is allowed to create,
+	// rename, close, and reopen forum topics;
+	// for supergroups only
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+
+	// Optional. True, if the administrator can manage direct
+	// messages of the channel and decline suggested posts;
+	// for channels only
+	CanManageDirectMessages bool `json:"can_manage_direct_messages,omitempty"`
+}
+
+// Describes actions that a non-administrator user is
+// allowed to take in a chat.
+type ChatPermissions struct {
+	// Optional. True, if the user is allowed to send text messages,
+	// contacts, giveaways, giveaway winners, invoices, locations
+	// and venues
+	CanSendMessages bool `json:"can_send_messages,omitempty"`
+
+	// Optional. True, if the user is allowed to send audios
+	CanSendAudios bool `json:"can_send_audios,omitempty"`
+
+	// Optional. True, if the user is allowed to send documents
+	CanSendDocuments bool `json:"can_send_documents,omitempty"`
+
+	// Optional. True, if the user is allowed to send photos
+	CanSendPhotos bool `json:"can_send_photos,omitempty"`
+
+	// Optional. True, if the user is allowed to send videos
+	CanSendVideos bool `json:"can_send_videos,omitempty"`
+
+	// Optional. True, if the user is allowed to send video notes
+	CanSendVideoNotes bool `json:"can_send_video_notes,omitempty"`
+
+	// Optional. True, if the user is allowed to send voice notes
+	CanSendVoiceNotes bool `json:"can_send_voice_notes,omitempty"`
+
+	// Optional. True, if the user is allowed to send polls and checklists
+	CanSendPolls bool `json:"can_send_polls,omitempty"`
+
+	// Optional. True, if the user is allowed to send animations,
+	// games, stickers and use inline bots
+	CanSendOtherMessages bool `json:"can_send_other_messages,omitempty"`
+
+	// Optional. True, if the user is allowed to add web
+	// page previews to their messages
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+
+	// Optional. True, if the user is allowed to change the
+	// chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info,omitempty"`
+
+	// Optional. True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+
+	// Optional. True, if the user is allowed to pin messages
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+
+	// Optional. True, if the user is allowed to create forum topics
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+}
+
+// AllChatPermissions returns permissions that allow every
+// action, which lifts all restrictions from a user.
+func AllChatPermissions() ChatPermissions {
+	return ChatPermissions{
+		CanSendMessages:       true,
+		CanSendAudios:         true,
+		CanSendDocuments:      true,
+		CanSendPhotos:         true,
+		CanSendVideos:         true,
+		CanSendVideoNotes:     true,
+		CanSendVoiceNotes:     true,
+		CanSendPolls:          true,
+		CanSendOtherMessages:  true,
+		CanAddWebPagePreviews: true,
+		CanChangeInfo:         true,
+		CanInviteUsers:        true,
+		CanPinMessages:        true,
+		CanManageTopics:       true,
+	}
+}
+
+// Represents a chat member that owns the chat
+// and has all administrator privileges.
+type ChatMemberOwner struct {
+	// The member's status in the chat, always “creator”
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+
+	// Optional. Custom title for this user
+	CustomTitle string `json:"custom_title,omitempty"`
+}
+
+// Represents a chat member that has some
+// additional privileges.
+type ChatMemberAdministrator struct {
+	// The member's status in the chat, always “administrator”
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// True, if the bot is allowed to edit
+	// administrator privileges of that user
+	CanBeEdited bool `json:"can_be_edited"`
+
+	// Rights of the administrator
+	ChatAdministratorRights
+
+	// Optional. Custom title for this user
+	CustomTitle string `json:"custom_title,omitempty"`
+}
+
+// Represents a chat member that has no additional
+// privileges or restrictions.
+type ChatMemberMember struct {
+	// The member's status in the chat, always “member”
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// Optional. Date when the user's subscription
+	// will expire; Unix time
+	UntilDate int `json:"until_date,omitempty"`
+}
+
+// Represents a chat member that is under certain
+// restrictions in the chat. Supergroups only.
+type ChatMemberRestricted struct {
+	// The member's status in the chat, always “restricted”
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// True, if the user is a member of the chat at the moment of the request
+	IsMember bool `json:"is_member"`
+
+	// Actions the user is allowed to take
+	ChatPermissions
+
+	// Date when restrictions will be lifted for this user;
+	// Unix time. If 0, then the user is restricted forever
+	UntilDate int `json:"until_date"`
+}
+
+// Represents a chat member that isn't currently a
+// member of the chat, but may join it themselves.
+type ChatMemberLeft struct {
+	// The member's status in the chat, always “left”
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+}
+
+// Represents a chat member that was banned in the chat
+// and can't return to the chat or view chat messages.
+type ChatMemberBanned struct {
+	// The member's status in the chat, always “kicked”
+	Status string `json:"status"`
+
+	// Information about the user
+	User User `json:"user"`
+
+	// Date when restrictions will be lifted for this user;
+	// Unix time. If 0, then the user is banned forever
+	UntilDate int `json:"until_date"`
+}
+
+// Until returns the time when restrictions will be
+// lifted, or zero time if the user is restricted forever.
+func (m ChatMemberRestricted) Until() time.Time {
+	return unixTime(m.UntilDate)
+}
+
+// Until returns the time when the ban will be
+// lifted, or zero time if the user is banned forever.
+func (m ChatMemberBanned) Until() time.Time {
+	return unixTime(m.UntilDate)
+}
+
+func (m *ChatMember) UnmarshalJSON(data []byte) error {
+	status, err := unionKind(data, "status")
+	if err != nil {
+		return err
+	}
+
+	*m = ChatMember{}
+
+	switch status {
+	case ChatMemberStatusCreator:
+		return unmarshalVariant(data, &m.Owner)
+	case ChatMemberStatusAdministrator:
+		return unmarshalVariant(data, &m.Administrator)
+	case ChatMemberStatusMember:
+		return unmarshalVariant(data, &m.Member)
+	case ChatMemberStatusRestricted:
+		return unmarshalVariant(data, &m.Restricted)
+	case ChatMemberStatusLeft:
+		return unmarshalVariant(data, &m.Left)
+	case ChatMemberStatusKicked:
+		return unmarshalVariant(data, &m.Banned)
+	default:
+		m.Unknown = unknownVariant(data)
+		return nil
+	}
+}
+
+func (m ChatMember) MarshalJSON() ([]byte, error) {
+	switch {
+	case m.Owner != nil:
+		return json.Marshal(m.Owner)
+	case m.Administrator != nil:
+		return json.Marshal(m.Administrator)
+	case m.Member != nil:
+		return json.Marshal(m.Member)
+	case m.Restricted != nil:
+		return json.Marshal(m.Restricted)
+	case m.Left != nil:
+		return json.Marshal(m.Left)
+	case m.Banned != nil:
+		return json.Marshal(m.Banned)
+	case m.Unknown != nil:
+		return m.Unknown, nil
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// Status returns the member's status in the chat,
+// or an empty string if the member is not set.
+// The status of an unknown member is read from its raw JSON.
+func (m ChatMember) Status() string {
+	switch {
+	case m.Owner != nil:
+		return ChatMemberStatusCreator
+	case m.Administrator != nil:
+		return ChatMemberStatusAdministrator
+	case m.Member != nil:
+		return ChatMemberStatusMember
+	case m.Restricted != nil:
+		return ChatMemberStatusRestricted
+	case m.Left != nil:
+		return ChatMemberStatusLeft
+	case m.Banned != nil:
+		return ChatMemberStatusKicked
+	case m.Unknown != nil:
+		status, _ := unionKind(m.Unknown, "status")
+		return status
+	default:
+		return ""
+	}
+}
+
+// User returns information about the member, or nil if the member is not set.
+func (m ChatMember) User() *User {
+	switch {
+	case m.Owner != nil:
+		return &m.Owner.User
+	case m.Administrator != nil:
+		return &m.Administrator.User
+	case m.Member != nil:
+		return &m.Member.User
+	case m.Restricted != nil:
+		return &m.Restricted.User
+	case m.Left != nil:
+		return &m.Left.User
+	case m.Banned != nil:
+		return &m.Banned.User
+	case m.Unknown != nil:
+		var member struct {
+			User *User `json:"user"`
+		}
+		if err := json.Unmarshal(m.Unknown, &member); err != nil {
+			return nil
+		}
+		return member.User
+	default:
+		return nil
+	}
+}
+
+// IsAdmin reports whether the member is the owner or an administrator of the chat.
+func (m ChatMember) IsAdmin() bool {
+	return m.Owner != nil || m.Administrator != nil
+}
+
+// IsMember reports whether the user is currently present in the chat.
+func (m ChatMember) IsMember() bool {
+	switch {
+	case m.Owner != nil, m.Administrator != nil, m.Member != nil:
+		return true
+	case m.Restricted != nil:
+		return m.Restricted.IsMember
+	default:
+		return false
+	}
+}
+
+// HasRight reports whether the member has the given administrator
+// right. The owner of the chat has all rights.
+func (m ChatMember) HasRight(right ChatRight) bool {
+	if m.Owner != nil {
+		return true
+	}
+
+	if m.Administrator == nil {
+		return false
+	}
+
+	return m.Administrator.Has(right)
+}
+
+// Has reports whether the given right is granted.
+func (r ChatAdministratorRights) Has(right ChatRight) bool {
+	switch right {
+	case RightCanManageChat:
+		return r.CanManageChat
+	case RightCanDeleteMessages:
+		return r.CanDeleteMessages
+	case RightCanManageVideoChats:
+		return r.CanManageVideoChats
+	case RightCanRestrictMembers:
+		return r.CanRestrictMembers
+	case RightCanPromoteMembers:
+		return r.CanPromoteMembers
+	case RightCanChangeInfo:
+		return r.CanChangeInfo
+	case RightCanInviteUsers:
+		return r.CanInviteUsers
+	case RightCanPostStories:
+		return r.CanPostStories
+	case RightCanEditStories:
+		return r.CanEditStories
+	case RightCanDeleteStories:
+		return r.CanDeleteStories
+	case RightCanPostMessages:
+		return r.CanPostMessages
+	case RightCanEditMessages:
+		return r.CanEditMessages
+	case RightCanPinMessages:
+		return r.CanPinMessages
+	case RightCanManageTopics:
+		return r.CanManageTopics
+	case RightCanManageDirectMessages:
+		return r.CanManageDirectMessages
+	default:
+		return false
+	}
+}
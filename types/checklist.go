@@ -51,7 +51,7 @@ type InputChecklistTask struct {
 	// Optional. Mode for parsing entities in the text.
 	// See formatting options for more details.
 	// (https://core.telegram.org/bots/api#formatting-options)
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the text,
 	// which can be specified instead of parse_mode. Currently,
@@ -69,7 +69,7 @@ type InputChecklist struct {
 	// Optional. Mode for parsing entities in the title.
 	// See formatting options for more details.
 	// (https://core.telegram.org/bots/api#formatting-options)
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the
 	// title, which can be specified instead of parse_mode.
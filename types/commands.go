@@ -1,8 +1,213 @@
 package types
 
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
 type Command string
 
 const (
 	CommandStart Command = "/start"
 	CommandHelp  Command = "/help"
 )
+
+// Name returns the command without the leading slash,
+// as it is used in BotCommand.
+func (c Command) Name() string {
+	return strings.TrimPrefix(string(c), "/")
+}
+
+// ParseCommand splits a message text like "/start@my_bot payload"
+// into the command, the username of the bot it is addressed to and
+// the arguments. It reports false if the text is not a command.
+func ParseCommand(text string) (command Command, username, args string, ok bool) {
+	if !strings.HasPrefix(text, "/") {
+		return "", "", "", false
+	}
+
+	head := text
+	if i := strings.IndexFunc(text, unicode.IsSpace); i >= 0 {
+		head, args = text[:i], strings.TrimLeftFunc(text[i:], unicode.IsSpace)
+	}
+
+	name, username, _ := strings.Cut(head, "@")
+	if name == "/" {
+		return "", "", "", false
+	}
+
+	return Command(name), username, args, true
+}
+
+// This object represents a bot command.
+type BotCommand struct {
+	// Text of the command; 1-32 characters. Can contain only
+	// lowercase English letters, digits and underscores.
+	Command string `json:"command"`
+
+	// Description of the command; 1-256 characters.
+	Description string `json:"description"`
+}
+
+const (
+	BotCommandScopeTypeDefault               = "default"
+	BotCommandScopeTypeAllPrivateChats       = "all_private_chats"
+	BotCommandScopeTypeAllGroupChats         = "all_group_chats"
+	BotCommandScopeTypeAllChatAdministrators = "all_chat_administrators"
+	BotCommandScopeTypeChat                  = "chat"
+	BotCommandScopeTypeChatAdministrators    = "chat_administrators"
+	BotCommandScopeTypeChatMember            = "chat_member"
+)
+
+// This object represents the scope to which bot commands are applied.
+// Exactly one of the fields is set.
+type BotCommandScope struct {
+	Default               *BotCommandScopeDefault
+	AllPrivateChats       *BotCommandScopeAllPrivateChats
+	AllGroupChats         *BotCommandScopeAllGroupChats
+	AllChatAdministrators *BotCommandScopeAllChatAdministrators
+	Chat                  *BotCommandScopeChat
+	ChatAdministrators    *BotCommandScopeChatAdministrators
+	ChatMember            *BotCommandScopeChatMember
+
+	// Optional. Raw JSON of a variant unknown
+	// to this version of the library
+	Unknown json.RawMessage
+}
+
+// Represents the default scope of bot commands. Default commands are
+// used if no commands with a narrower scope are specified for the user.
+type BotCommandScopeDefault struct {
+	// Scope type, must be default
+	Type string `json:"type"`
+}
+
+// Represents the scope of bot commands, covering all private chats.
+type BotCommandScopeAllPrivateChats struct {
+	// Scope type, must be all_private_chats
+	Type string `json:"type"`
+}
+
+// Represents the scope of bot commands, covering all group and supergroup chats.
+type BotCommandScopeAllGroupChats struct {
+	// Scope type, must be all_group_chats
+	Type string `json:"type"`
+}
+
+// Represents the scope of bot commands, covering
+// all group and supergroup chat administrators.
+type BotCommandScopeAllChatAdministrators struct {
+	// Scope type, must be all_chat_administrators
+	Type string `json:"type"`
+}
+
+// Represents the scope of bot commands, covering a specific chat.
+type BotCommandScopeChat struct {
+	// Scope type, must be chat
+	Type string `json:"type"`
+
+	// Unique identifier for the target chat or username of the target
+	// supergroup (in the format @supergroupusername). Direct messages
+	// chats and chats of the type “private” are not supported.
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Represents the scope of bot commands, covering all administrators
+// of a specific group or supergroup chat.
+type BotCommandScopeChatAdministrators struct {
+	// Scope type, must be chat_administrators
+	Type string `json:"type"`
+
+	// Unique identifier for the target chat or username of the target
+	// supergroup (in the format @supergroupusername). Direct messages
+	// chats are not supported.
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Represents the scope of bot commands, covering a specific
+// member of a group or supergroup chat.
+type BotCommandScopeChatMember struct {
+	// Scope type, must be chat_member
+	Type string `json:"type"`
+
+	// Unique identifier for the target chat or username of the target
+	// supergroup (in the format @supergroupusername). Direct messages
+	// chats and chats of the type “private” are not supported.
+	ChatID ChatID `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+func (s *BotCommandScope) UnmarshalJSON(data []byte) error {
+	kind, err := unionKind(data, "type")
+	if err != nil {
+		return err
+	}
+
+	*s = BotCommandScope{}
+
+	switch kind {
+	case BotCommandScopeTypeDefault:
+		return unmarshalVariant(data, &s.Default)
+	case BotCommandScopeTypeAllPrivateChats:
+		return unmarshalVariant(data, &s.AllPrivateChats)
+	case BotCommandScopeTypeAllGroupChats:
+		return unmarshalVariant(data, &s.AllGroupChats)
+	case BotCommandScopeTypeAllChatAdministrators:
+		return unmarshalVariant(data, &s.AllChatAdministrators)
+	case BotCommandScopeTypeChat:
+		return unmarshalVariant(data, &s.Chat)
+	case BotCommandScopeTypeChatAdministrators:
+		return unmarshalVariant(data, &s.ChatAdministrators)
+	case BotCommandScopeTypeChatMember:
+		return unmarshalVariant(data, &s.ChatMember)
+	default:
+		s.Unknown = unknownVariant(data)
+		return nil
+	}
+}
+
+// MarshalJSON fills in the type of the scope,
+// so it can be omitted when building scopes.
+// An empty scope is the default one.
+func (s BotCommandScope) MarshalJSON() ([]byte, error) {
+	switch {
+	case s.AllPrivateChats != nil:
+		return json.Marshal(BotCommandScopeAllPrivateChats{
+			Type: BotCommandScopeTypeAllPrivateChats,
+		})
+
+	case s.AllGroupChats != nil:
+		return json.Marshal(BotCommandScopeAllGroupChats{
+			Type: BotCommandScopeTypeAllGroupChats,
+		})
+
+	case s.AllChatAdministrators != nil:
+		return json.Marshal(BotCommandScopeAllChatAdministrators{
+			Type: BotCommandScopeTypeAllChatAdministrators,
+		})
+
+	case s.Chat != nil:
+		v := *s.Chat
+		v.Type = BotCommandScopeTypeChat
+		return json.Marshal(v)
+
+	case s.ChatAdministrators != nil:
+		v := *s.ChatAdministrators
+		v.Type = BotCommandScopeTypeChatAdministrators
+		return json.Marshal(v)
+
+	case s.ChatMember != nil:
+		v := *s.ChatMember
+		v.Type = BotCommandScopeTypeChatMember
+		return json.Marshal(v)
+
+	case s.Unknown != nil:
+		return s.Unknown, nil
+
+	default:
+		return json.Marshal(BotCommandScopeDefault{Type: BotCommandScopeTypeDefault})
+	}
+}
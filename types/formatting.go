@@ -0,0 +1,36 @@
+package types
+
+// Mode for parsing entities in the text of a message. See formatting
+// options (https://core.telegram.org/bots/api#formatting-options).
+type ParseMode string
+
+const (
+	ParseModeHTML       ParseMode = "HTML"
+	ParseModeMarkdownV2 ParseMode = "MarkdownV2"
+
+	// Legacy mode, supported for backward compatibility only.
+	ParseModeMarkdown ParseMode = "Markdown"
+)
+
+// Types of MessageEntity.
+const (
+	EntityTypeMention              = "mention"
+	EntityTypeHashtag              = "hashtag"
+	EntityTypeCashtag              = "cashtag"
+	EntityTypeBotCommand           = "bot_command"
+	EntityTypeURL                  = "url"
+	EntityTypeEmail                = "email"
+	EntityTypePhoneNumber          = "phone_number"
+	EntityTypeBold                 = "bold"
+	EntityTypeItalic               = "italic"
+	EntityTypeUnderline            = "underline"
+	EntityTypeStrikethrough        = "strikethrough"
+	EntityTypeSpoiler              = "spoiler"
+	EntityTypeBlockquote           = "blockquote"
+	EntityTypeExpandableBlockquote = "expandable_blockquote"
+	EntityTypeCode                 = "code"
+	EntityTypePre                  = "pre"
+	EntityTypeTextLink             = "text_link"
+	EntityTypeTextMention          = "text_mention"
+	EntityTypeCustomEmoji          = "custom_emoji"
+)
@@ -1,5 +1,31 @@
 package types
 
+// Colors of forum topic icons allowed in createForumTopic
+const (
+	ForumTopicIconColorBlue   = 0x6FB9F0
+	ForumTopicIconColorYellow = 0xFFD67E
+	ForumTopicIconColorViolet = 0xCB86DB
+	ForumTopicIconColorGreen  = 0x8EEE98
+	ForumTopicIconColorRose   = 0xFF93B2
+	ForumTopicIconColorRed    = 0xFB6F5F
+)
+
+// This object represents a forum topic.
+type ForumTopic struct {
+	// Unique identifier of the forum topic
+	MessageThreadID int `json:"message_thread_id"`
+
+	// Name of the topic
+	Name string `json:"name"`
+
+	// Color of the topic icon in RGB format
+	IconColor int `json:"icon_color"`
+
+	// Optional. Unique identifier of the custom
+	// emoji shown as the topic icon
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
 // This object represents a service message
 // about a new forum topic created in the chat.
 type ForumTopicCreated struct {
@@ -11,7 +37,7 @@ type ForumTopicCreated struct {
 
 	// Optional. Unique identifier of the custom
 	// emoji shown as the topic icon
-	IconCustomEmojiID string `json:"icon_custom_emoji_id"`
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
 }
 
 // This object represents a service message
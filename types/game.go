@@ -27,3 +27,7 @@ type Game struct {
 	// the game message in chats. Upload via BotFather
 	Animation *Animation `json:"animation,omitempty"`
 }
+
+// A placeholder, currently holds no information.
+// Use BotFather to set up your game.
+type CallbackGame struct{}
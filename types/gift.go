@@ -173,3 +173,18 @@ type UniqueGiftInfo struct {
 	// If it is in the past, then the gift can be transferred now
 	NextTransferDate int `json:"next_transfer_date,omitempty"`
 }
+
+// This object describes the types of gifts that can be gifted to a user or a chat.
+type AcceptedGiftTypes struct {
+	// True, if unlimited regular gifts are accepted
+	UnlimitedGifts bool `json:"unlimited_gifts"`
+
+	// True, if limited regular gifts are accepted
+	LimitedGifts bool `json:"limited_gifts"`
+
+	// True, if unique gifts or gifts that can be upgraded to unique for free are accepted
+	UniqueGifts bool `json:"unique_gifts"`
+
+	// True, if a Telegram Premium subscription is accepted
+	PremiumSubscription bool `json:"premium_subscription"`
+}
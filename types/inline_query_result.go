@@ -0,0 +1,1021 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+const (
+	InlineQueryResultTypeArticle  = "article"
+	InlineQueryResultTypePhoto    = "photo"
+	InlineQueryResultTypeGif      = "gif"
+	InlineQueryResultTypeMpeg4Gif = "mpeg4_gif"
+	InlineQueryResultTypeVideo    = "video"
+	InlineQueryResultTypeAudio    = "audio"
+	InlineQueryResultTypeVoice    = "voice"
+	InlineQueryResultTypeDocument = "document"
+	InlineQueryResultTypeLocation = "location"
+	InlineQueryResultTypeVenue    = "venue"
+	InlineQueryResultTypeContact  = "contact"
+	InlineQueryResultTypeGame     = "game"
+	InlineQueryResultTypeSticker  = "sticker"
+)
+
+// This object represents one result of an inline query.
+// Exactly one of the fields is set.
+type InlineQueryResult struct {
+	Article  *InlineQueryResultArticle
+	Photo    *InlineQueryResultPhoto
+	Gif      *InlineQueryResultGif
+	Mpeg4Gif *InlineQueryResultMpeg4Gif
+	Video    *InlineQueryResultVideo
+	Audio    *InlineQueryResultAudio
+	Voice    *InlineQueryResultVoice
+	Document *InlineQueryResultDocument
+	Location *InlineQueryResultLocation
+	Venue    *InlineQueryResultVenue
+	Contact  *InlineQueryResultContact
+	Game     *InlineQueryResultGame
+
+	CachedPhoto    *InlineQueryResultCachedPhoto
+	CachedGif      *InlineQueryResultCachedGif
+	CachedMpeg4Gif *InlineQueryResultCachedMpeg4Gif
+	CachedSticker  *InlineQueryResultCachedSticker
+	CachedDocument *InlineQueryResultCachedDocument
+	CachedVideo    *InlineQueryResultCachedVideo
+	CachedVoice    *InlineQueryResultCachedVoice
+	CachedAudio    *InlineQueryResultCachedAudio
+}
+
+// Represents a link to an article or web page.
+type InlineQueryResultArticle struct {
+	// Type of the result, must be article
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 Bytes
+	ID string `json:"id"`
+
+	// Title of the result
+	Title string `json:"title"`
+
+	// Content of the message to be sent
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. URL of the result
+	URL string `json:"url,omitempty"`
+
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+
+	// Optional. Url of the thumbnail for the result
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+
+	// Optional. Thumbnail width
+	ThumbnailWidth int `json:"thumbnail_width,omitempty"`
+
+	// Optional. Thumbnail height
+	ThumbnailHeight int `json:"thumbnail_height,omitempty"`
+}
+
+// Represents a link to a photo. By default, this photo will be sent by
+// the user with optional caption. Alternatively, you can use
+// input_message_content to send a message with the specified content
+// instead of the photo.
+type InlineQueryResultPhoto struct {
+	// Type of the result, must be photo
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid URL of the photo. Photo must be in JPEG format.
+	// Photo size must not exceed 5MB
+	PhotoURL string `json:"photo_url"`
+
+	// URL of the thumbnail for the photo
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// Optional. Width of the photo
+	PhotoWidth int `json:"photo_width,omitempty"`
+
+	// Optional. Height of the photo
+	PhotoHeight int `json:"photo_height,omitempty"`
+
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+
+	// Optional. Caption of the photo to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the photo caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the photo
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to an animated GIF file. By default, this animated
+// GIF file will be sent by the user with optional caption.
+// Alternatively, you can use input_message_content to send a message
+// with the specified content instead of the animation.
+type InlineQueryResultGif struct {
+	// Type of the result, must be gif
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid URL for the GIF file
+	GifURL string `json:"gif_url"`
+
+	// Optional. Width of the GIF
+	GifWidth int `json:"gif_width,omitempty"`
+
+	// Optional. Height of the GIF
+	GifHeight int `json:"gif_height,omitempty"`
+
+	// Optional. Duration of the GIF in seconds
+	GifDuration int `json:"gif_duration,omitempty"`
+
+	// URL of the static (JPEG or GIF) or animated (MPEG4)
+	// thumbnail for the result
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// Optional. MIME type of the thumbnail, must be one of
+	// “image/jpeg”, “image/gif”, or “video/mp4”.
+	// Defaults to “image/jpeg”
+	ThumbnailMimeType string `json:"thumbnail_mime_type,omitempty"`
+
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+
+	// Optional. Caption of the GIF file to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the GIF animation
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to a video animation (H.264/MPEG-4 AVC video without
+// sound). By default, this animated MPEG-4 file will be sent by the user
+// with optional caption. Alternatively, you can use input_message_content
+// to send a message with the specified content instead of the animation.
+type InlineQueryResultMpeg4Gif struct {
+	// Type of the result, must be mpeg4_gif
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid URL for the MPEG4 file
+	Mpeg4URL string `json:"mpeg4_url"`
+
+	// Optional. Video width
+	Mpeg4Width int `json:"mpeg4_width,omitempty"`
+
+	// Optional. Video height
+	Mpeg4Height int `json:"mpeg4_height,omitempty"`
+
+	// Optional. Video duration in seconds
+	Mpeg4Duration int `json:"mpeg4_duration,omitempty"`
+
+	// URL of the static (JPEG or GIF) or animated (MPEG4)
+	// thumbnail for the result
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// Optional. MIME type of the thumbnail, must be one of
+	// “image/jpeg”, “image/gif”, or “video/mp4”.
+	// Defaults to “image/jpeg”
+	ThumbnailMimeType string `json:"thumbnail_mime_type,omitempty"`
+
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+
+	// Optional. Caption of the MPEG-4 file to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the video animation
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to a page containing an embedded video player or a
+// video file. By default, this video file will be sent by the user with
+// an optional caption. Alternatively, you can use input_message_content
+// to send a message with the specified content instead of the video.
+//
+// If an InlineQueryResultVideo message contains an embedded video
+// (e.g., YouTube), you must replace its content using
+// input_message_content.
+type InlineQueryResultVideo struct {
+	// Type of the result, must be video
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid URL for the embedded video player or video file
+	VideoURL string `json:"video_url"`
+
+	// MIME type of the content of the video URL,
+	// “text/html” or “video/mp4”
+	MimeType string `json:"mime_type"`
+
+	// URL of the thumbnail (JPEG only) for the video
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// Title for the result
+	Title string `json:"title"`
+
+	// Optional. Caption of the video to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the video caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Video width
+	VideoWidth int `json:"video_width,omitempty"`
+
+	// Optional. Video height
+	VideoHeight int `json:"video_height,omitempty"`
+
+	// Optional. Video duration in seconds
+	VideoDuration int `json:"video_duration,omitempty"`
+
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the video.
+	// This field is required if InlineQueryResultVideo is used to send
+	// an HTML-page as a result (e.g., a YouTube video).
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to an MP3 audio file. By default, this audio file
+// will be sent by the user. Alternatively, you can use
+// input_message_content to send a message with the specified content
+// instead of the audio.
+type InlineQueryResultAudio struct {
+	// Type of the result, must be audio
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid URL for the audio file
+	AudioURL string `json:"audio_url"`
+
+	// Title
+	Title string `json:"title"`
+
+	// Optional. Caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the audio caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Performer
+	Performer string `json:"performer,omitempty"`
+
+	// Optional. Audio duration in seconds
+	AudioDuration int `json:"audio_duration,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the audio
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to a voice recording in an .OGG container encoded
+// with OPUS. By default, this voice recording will be sent by the user.
+// Alternatively, you can use input_message_content to send a message
+// with the specified content instead of the voice message.
+type InlineQueryResultVoice struct {
+	// Type of the result, must be voice
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid URL for the voice recording
+	VoiceURL string `json:"voice_url"`
+
+	// Recording title
+	Title string `json:"title"`
+
+	// Optional. Caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the voice message caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Recording duration in seconds
+	VoiceDuration int `json:"voice_duration,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the voice recording
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to a file. By default, this file will be sent by the
+// user with an optional caption. Alternatively, you can use
+// input_message_content to send a message with the specified content
+// instead of the file. Currently, only .PDF and .ZIP files can be sent
+// using this method.
+type InlineQueryResultDocument struct {
+	// Type of the result, must be document
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// Title for the result
+	Title string `json:"title"`
+
+	// Optional. Caption of the document to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the document caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// A valid URL for the file
+	DocumentURL string `json:"document_url"`
+
+	// MIME type of the content of the file,
+	// either “application/pdf” or “application/zip”
+	MimeType string `json:"mime_type"`
+
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the file
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+
+	// Optional. URL of the thumbnail (JPEG only) for the file
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+
+	// Optional. Thumbnail width
+	ThumbnailWidth int `json:"thumbnail_width,omitempty"`
+
+	// Optional. Thumbnail height
+	ThumbnailHeight int `json:"thumbnail_height,omitempty"`
+}
+
+// Represents a location on a map. By default, the location will be
+// sent by the user. Alternatively, you can use input_message_content
+// to send a message with the specified content instead of the location.
+type InlineQueryResultLocation struct {
+	// Type of the result, must be location
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 Bytes
+	ID string `json:"id"`
+
+	// Location latitude in degrees
+	Latitude float64 `json:"latitude"`
+
+	// Location longitude in degrees
+	Longitude float64 `json:"longitude"`
+
+	// Location title
+	Title string `json:"title"`
+
+	// Optional. The radius of uncertainty for the location,
+	// measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+
+	// Optional. Period in seconds during which the location can be
+	// updated, should be between 60 and 86400, or 0x7FFFFFFF for
+	// live locations that can be edited indefinitely.
+	LivePeriod int `json:"live_period,omitempty"`
+
+	// Optional. For live locations, a direction in which the user is
+	// moving, in degrees. Must be between 1 and 360 if specified.
+	Heading int `json:"heading,omitempty"`
+
+	// Optional. For live locations, a maximum distance for proximity
+	// alerts about approaching another chat member, in meters.
+	// Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the location
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+
+	// Optional. Url of the thumbnail for the result
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+
+	// Optional. Thumbnail width
+	ThumbnailWidth int `json:"thumbnail_width,omitempty"`
+
+	// Optional. Thumbnail height
+	ThumbnailHeight int `json:"thumbnail_height,omitempty"`
+}
+
+// Represents a venue. By default, the venue will be sent by the user.
+// Alternatively, you can use input_message_content to send a message
+// with the specified content instead of the venue.
+type InlineQueryResultVenue struct {
+	// Type of the result, must be venue
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 Bytes
+	ID string `json:"id"`
+
+	// Latitude of the venue location in degrees
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the venue location in degrees
+	Longitude float64 `json:"longitude"`
+
+	// Title of the venue
+	Title string `json:"title"`
+
+	// Address of the venue
+	Address string `json:"address"`
+
+	// Optional. Foursquare identifier of the venue if known
+	FoursquareID string `json:"foursquare_id,omitempty"`
+
+	// Optional. Foursquare type of the venue, if known. (For example,
+	// “arts_entertainment/default”, “arts_entertainment/aquarium”
+	// or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+
+	// Optional. Google Places identifier of the venue
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+
+	// Optional. Google Places type of the venue.
+	// (https://developers.google.com/places/web-service/supported_types)
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the venue
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+
+	// Optional. Url of the thumbnail for the result
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+
+	// Optional. Thumbnail width
+	ThumbnailWidth int `json:"thumbnail_width,omitempty"`
+
+	// Optional. Thumbnail height
+	ThumbnailHeight int `json:"thumbnail_height,omitempty"`
+}
+
+// Represents a contact with a phone number. By default, this contact
+// will be sent by the user. Alternatively, you can use
+// input_message_content to send a message with the specified content
+// instead of the contact.
+type InlineQueryResultContact struct {
+	// Type of the result, must be contact
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 Bytes
+	ID string `json:"id"`
+
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+
+	// Contact's first name
+	FirstName string `json:"first_name"`
+
+	// Optional. Contact's last name
+	LastName string `json:"last_name,omitempty"`
+
+	// Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the contact
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+
+	// Optional. Url of the thumbnail for the result
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+
+	// Optional. Thumbnail width
+	ThumbnailWidth int `json:"thumbnail_width,omitempty"`
+
+	// Optional. Thumbnail height
+	ThumbnailHeight int `json:"thumbnail_height,omitempty"`
+}
+
+// Represents a Game.
+type InlineQueryResultGame struct {
+	// Type of the result, must be game
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// Short name of the game
+	GameShortName string `json:"game_short_name"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Represents a link to a photo stored on the Telegram servers. By
+// default, this photo will be sent by the user with an optional
+// caption. Alternatively, you can use input_message_content to send
+// a message with the specified content instead of the photo.
+type InlineQueryResultCachedPhoto struct {
+	// Type of the result, must be photo
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid file identifier of the photo
+	PhotoFileID string `json:"photo_file_id"`
+
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+
+	// Optional. Caption of the photo to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the photo caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the photo
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to an animated GIF file stored on the Telegram
+// servers. By default, this animated GIF file will be sent by the user
+// with an optional caption. Alternatively, you can use
+// input_message_content to send a message with specified content
+// instead of the animation.
+type InlineQueryResultCachedGif struct {
+	// Type of the result, must be gif
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid file identifier for the GIF file
+	GifFileID string `json:"gif_file_id"`
+
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+
+	// Optional. Caption of the GIF file to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the GIF animation
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to a video animation (H.264/MPEG-4 AVC video without
+// sound) stored on the Telegram servers. By default, this animated
+// MPEG-4 file will be sent by the user with an optional caption.
+// Alternatively, you can use input_message_content to send a message
+// with the specified content instead of the animation.
+type InlineQueryResultCachedMpeg4Gif struct {
+	// Type of the result, must be mpeg4_gif
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid file identifier for the MPEG4 file
+	Mpeg4FileID string `json:"mpeg4_file_id"`
+
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+
+	// Optional. Caption of the MPEG-4 file to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the video animation
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to a sticker stored on the Telegram servers. By
+// default, this sticker will be sent by the user. Alternatively, you
+// can use input_message_content to send a message with the specified
+// content instead of the sticker.
+type InlineQueryResultCachedSticker struct {
+	// Type of the result, must be sticker
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid file identifier of the sticker
+	StickerFileID string `json:"sticker_file_id"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the sticker
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to a file stored on the Telegram servers. By
+// default, this file will be sent by the user with an optional caption.
+// Alternatively, you can use input_message_content to send a message
+// with the specified content instead of the file.
+type InlineQueryResultCachedDocument struct {
+	// Type of the result, must be document
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// Title for the result
+	Title string `json:"title"`
+
+	// A valid file identifier for the file
+	DocumentFileID string `json:"document_file_id"`
+
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+
+	// Optional. Caption of the document to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the document caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the file
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to a video file stored on the Telegram servers. By
+// default, this video file will be sent by the user with an optional
+// caption. Alternatively, you can use input_message_content to send a
+// message with the specified content instead of the video.
+type InlineQueryResultCachedVideo struct {
+	// Type of the result, must be video
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid file identifier for the video file
+	VideoFileID string `json:"video_file_id"`
+
+	// Title for the result
+	Title string `json:"title"`
+
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+
+	// Optional. Caption of the video to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the video caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the video
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to a voice message stored on the Telegram servers.
+// By default, this voice message will be sent by the user.
+// Alternatively, you can use input_message_content to send a message
+// with the specified content instead of the voice message.
+type InlineQueryResultCachedVoice struct {
+	// Type of the result, must be voice
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid file identifier for the voice message
+	VoiceFileID string `json:"voice_file_id"`
+
+	// Voice message title
+	Title string `json:"title"`
+
+	// Optional. Caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the voice message caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the voice message
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// Represents a link to an MP3 audio file stored on the Telegram servers.
+// By default, this audio file will be sent by the user. Alternatively,
+// you can use input_message_content to send a message with the
+// specified content instead of the audio.
+type InlineQueryResultCachedAudio struct {
+	// Type of the result, must be audio
+	Type string `json:"type"`
+
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+
+	// A valid file identifier for the audio file
+	AudioFileID string `json:"audio_file_id"`
+
+	// Optional. Caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the audio caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	// Optional. Content of the message to be sent instead of the audio
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// This object represents a button to be shown above inline query
+// results. You must use exactly one of the optional fields.
+type InlineQueryResultsButton struct {
+	// Label text on the button
+	Text string `json:"text"`
+
+	// Optional. Description of the Web App that will be launched when
+	// the user presses the button. The Web App will be able to switch
+	// back to the inline mode using the method switchInlineQuery inside
+	// the Web App.
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+
+	// Optional. Deep-linking parameter for the /start message sent to
+	// the bot when a user presses the button. 1-64 characters, only
+	// A-Z, a-z, 0-9, _ and - are allowed.
+	StartParameter string `json:"start_parameter,omitempty"`
+}
+
+// MarshalJSON fills in the type of the result,
+// so it can be omitted when building results.
+func (r InlineQueryResult) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.Article != nil:
+		v := *r.Article
+		v.Type = InlineQueryResultTypeArticle
+		return json.Marshal(v)
+
+	case r.Photo != nil:
+		v := *r.Photo
+		v.Type = InlineQueryResultTypePhoto
+		return json.Marshal(v)
+
+	case r.Gif != nil:
+		v := *r.Gif
+		v.Type = InlineQueryResultTypeGif
+		return json.Marshal(v)
+
+	case r.Mpeg4Gif != nil:
+		v := *r.Mpeg4Gif
+		v.Type = InlineQueryResultTypeMpeg4Gif
+		return json.Marshal(v)
+
+	case r.Video != nil:
+		v := *r.Video
+		v.Type = InlineQueryResultTypeVideo
+		return json.Marshal(v)
+
+	case r.Audio != nil:
+		v := *r.Audio
+		v.Type = InlineQueryResultTypeAudio
+		return json.Marshal(v)
+
+	case r.Voice != nil:
+		v := *r.Voice
+		v.Type = InlineQueryResultTypeVoice
+		return json.Marshal(v)
+
+	case r.Document != nil:
+		v := *r.Document
+		v.Type = InlineQueryResultTypeDocument
+		return json.Marshal(v)
+
+	case r.Location != nil:
+		v := *r.Location
+		v.Type = InlineQueryResultTypeLocation
+		return json.Marshal(v)
+
+	case r.Venue != nil:
+		v := *r.Venue
+		v.Type = InlineQueryResultTypeVenue
+		return json.Marshal(v)
+
+	case r.Contact != nil:
+		v := *r.Contact
+		v.Type = InlineQueryResultTypeContact
+		return json.Marshal(v)
+
+	case r.Game != nil:
+		v := *r.Game
+		v.Type = InlineQueryResultTypeGame
+		return json.Marshal(v)
+
+	case r.CachedPhoto != nil:
+		v := *r.CachedPhoto
+		v.Type = InlineQueryResultTypePhoto
+		return json.Marshal(v)
+
+	case r.CachedGif != nil:
+		v := *r.CachedGif
+		v.Type = InlineQueryResultTypeGif
+		return json.Marshal(v)
+
+	case r.CachedMpeg4Gif != nil:
+		v := *r.CachedMpeg4Gif
+		v.Type = InlineQueryResultTypeMpeg4Gif
+		return json.Marshal(v)
+
+	case r.CachedSticker != nil:
+		v := *r.CachedSticker
+		v.Type = InlineQueryResultTypeSticker
+		return json.Marshal(v)
+
+	case r.CachedDocument != nil:
+		v := *r.CachedDocument
+		v.Type = InlineQueryResultTypeDocument
+		return json.Marshal(v)
+
+	case r.CachedVideo != nil:
+		v := *r.CachedVideo
+		v.Type = InlineQueryResultTypeVideo
+		return json.Marshal(v)
+
+	case r.CachedVoice != nil:
+		v := *r.CachedVoice
+		v.Type = InlineQueryResultTypeVoice
+		return json.Marshal(v)
+
+	case r.CachedAudio != nil:
+		v := *r.CachedAudio
+		v.Type = InlineQueryResultTypeAudio
+		return json.Marshal(v)
+
+	default:
+		return nil, errors.New("inline query result is empty")
+	}
+}
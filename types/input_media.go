@@ -0,0 +1,241 @@
+package types
+
+import "encoding/json"
+
+const (
+	InputMediaTypePhoto     = "photo"
+	InputMediaTypeVideo     = "video"
+	InputMediaTypeAnimation = "animation"
+	InputMediaTypeAudio     = "audio"
+	InputMediaTypeDocument  = "document"
+)
+
+// This object represents the content of a media message to be sent.
+// Exactly one of the fields is set.
+//
+// Media is referenced by a file_id that exists on the Telegram
+// servers or by an HTTP URL for Telegram to get a file from the Internet.
+type InputMedia struct {
+	Photo     *InputMediaPhoto
+	Video     *InputMediaVideo
+	Animation *InputMediaAnimation
+	Audio     *InputMediaAudio
+	Document  *InputMediaDocument
+}
+
+// Represents a photo to be sent.
+type InputMediaPhoto struct {
+	// Type of the result, must be photo
+	Type string `json:"type"`
+
+	// File to send. Pass a file_id to send a file that exists on
+	// the Telegram servers (recommended) or pass an HTTP URL for
+	// Telegram to get a file from the Internet
+	Media string `json:"media"`
+
+	// Optional. Caption of the photo to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the photo caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Pass True if the photo needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// Represents a video to be sent.
+type InputMediaVideo struct {
+	// Type of the result, must be video
+	Type string `json:"type"`
+
+	// File to send. Pass a file_id to send a file that exists on
+	// the Telegram servers (recommended) or pass an HTTP URL for
+	// Telegram to get a file from the Internet
+	Media string `json:"media"`
+
+	// Optional. Cover for the video in the message. Pass a file_id
+	// to send a file that exists on the Telegram servers (recommended)
+	// or pass an HTTP URL for Telegram to get a file from the Internet
+	Cover string `json:"cover,omitempty"`
+
+	// Optional. Start timestamp for the video in the message
+	StartTimestamp int `json:"start_timestamp,omitempty"`
+
+	// Optional. Caption of the video to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the video caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Video width
+	Width int `json:"width,omitempty"`
+
+	// Optional. Video height
+	Height int `json:"height,omitempty"`
+
+	// Optional. Video duration in seconds
+	Duration int `json:"duration,omitempty"`
+
+	// Optional. Pass True if the uploaded video is suitable for streaming
+	SupportsStreaming bool `json:"supports_streaming,omitempty"`
+
+	// Optional. Pass True if the video needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// Represents an animation file (GIF or H.264/MPEG-4
+// AVC video without sound) to be sent.
+type InputMediaAnimation struct {
+	// Type of the result, must be animation
+	Type string `json:"type"`
+
+	// File to send. Pass a file_id to send a file that exists on
+	// the Telegram servers (recommended) or pass an HTTP URL for
+	// Telegram to get a file from the Internet
+	Media string `json:"media"`
+
+	// Optional. Caption of the animation to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the animation caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True, if the caption must be shown above the message media
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+
+	// Optional. Animation width
+	Width int `json:"width,omitempty"`
+
+	// Optional. Animation height
+	Height int `json:"height,omitempty"`
+
+	// Optional. Animation duration in seconds
+	Duration int `json:"duration,omitempty"`
+
+	// Optional. Pass True if the animation needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// Represents an audio file to be treated as music to be sent.
+type InputMediaAudio struct {
+	// Type of the result, must be audio
+	Type string `json:"type"`
+
+	// File to send. Pass a file_id to send a file that exists on
+	// the Telegram servers (recommended) or pass an HTTP URL for
+	// Telegram to get a file from the Internet
+	Media string `json:"media"`
+
+	// Optional. Caption of the audio to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the audio caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Duration of the audio in seconds
+	Duration int `json:"duration,omitempty"`
+
+	// Optional. Performer of the audio
+	Performer string `json:"performer,omitempty"`
+
+	// Optional. Title of the audio
+	Title string `json:"title,omitempty"`
+}
+
+// Represents a general file to be sent.
+type InputMediaDocument struct {
+	// Type of the result, must be document
+	Type string `json:"type"`
+
+	// File to send. Pass a file_id to send a file that exists on
+	// the Telegram servers (recommended) or pass an HTTP URL for
+	// Telegram to get a file from the Internet
+	Media string `json:"media"`
+
+	// Optional. Caption of the document to be sent,
+	// 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the document caption.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in the
+	// caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Disables automatic server-side content type detection
+	// for files uploaded using multipart/form-data. Always True, if
+	// the document is sent as part of an album.
+	DisableContentTypeDetection bool `json:"disable_content_type_detection,omitempty"`
+}
+
+// IsZero reports whether none of the variants is set.
+func (m InputMedia) IsZero() bool {
+	return m.Photo == nil && m.Video == nil && m.Animation == nil &&
+		m.Audio == nil && m.Document == nil
+}
+
+// MarshalJSON fills in the type of the media,
+// so it can be omitted when building media.
+func (m InputMedia) MarshalJSON() ([]byte, error) {
+	switch {
+	case m.Photo != nil:
+		v := *m.Photo
+		v.Type = InputMediaTypePhoto
+		return json.Marshal(v)
+
+	case m.Video != nil:
+		v := *m.Video
+		v.Type = InputMediaTypeVideo
+		return json.Marshal(v)
+
+	case m.Animation != nil:
+		v := *m.Animation
+		v.Type = InputMediaTypeAnimation
+		return json.Marshal(v)
+
+	case m.Audio != nil:
+		v := *m.Audio
+		v.Type = InputMediaTypeAudio
+		return json.Marshal(v)
+
+	case m.Document != nil:
+		v := *m.Document
+		v.Type = InputMediaTypeDocument
+		return json.Marshal(v)
+
+	default:
+		return []byte("null"), nil
+	}
+}
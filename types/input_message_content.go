@@ -0,0 +1,210 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// This object represents the content of a message to be sent
+// as a result of an inline query. Exactly one of the fields is set.
+type InputMessageContent struct {
+	Text     *InputTextMessageContent
+	Location *InputLocationMessageContent
+	Venue    *InputVenueMessageContent
+	Contact  *InputContactMessageContent
+	Invoice  *InputInvoiceMessageContent
+}
+
+// Represents the content of a text message to be sent as the result of an inline query.
+type InputTextMessageContent struct {
+	// Text of the message to be sent, 1-4096 characters
+	MessageText string `json:"message_text"`
+
+	// Optional. Mode for parsing entities in the message text.
+	// See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Optional. List of special entities that appear in message
+	// text, which can be specified instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+
+	// Optional. Link preview generation options for the message
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+}
+
+// Represents the content of a location message to be sent as the result of an inline query.
+type InputLocationMessageContent struct {
+	// Latitude of the location in degrees
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the location in degrees
+	Longitude float64 `json:"longitude"`
+
+	// Optional. The radius of uncertainty for the location,
+	// measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+
+	// Optional. Period in seconds during which the location can be
+	// updated, should be between 60 and 86400, or 0x7FFFFFFF for
+	// live locations that can be edited indefinitely.
+	LivePeriod int `json:"live_period,omitempty"`
+
+	// Optional. For live locations, a direction in which the user is
+	// moving, in degrees. Must be between 1 and 360 if specified.
+	Heading int `json:"heading,omitempty"`
+
+	// Optional. For live locations, a maximum distance for proximity
+	// alerts about approaching another chat member, in meters.
+	// Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+}
+
+// Represents the content of a venue message to be sent as the result of an inline query.
+type InputVenueMessageContent struct {
+	// Latitude of the venue in degrees
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the venue in degrees
+	Longitude float64 `json:"longitude"`
+
+	// Name of the venue
+	Title string `json:"title"`
+
+	// Address of the venue
+	Address string `json:"address"`
+
+	// Optional. Foursquare identifier of the venue, if known
+	FoursquareID string `json:"foursquare_id,omitempty"`
+
+	// Optional. Foursquare type of the venue, if known. (For example,
+	// “arts_entertainment/default”, “arts_entertainment/aquarium”
+	// or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+
+	// Optional. Google Places identifier of the venue
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+
+	// Optional. Google Places type of the venue.
+	// (https://developers.google.com/places/web-service/supported_types)
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+}
+
+// Represents the content of a contact message to be sent as the result of an inline query.
+type InputContactMessageContent struct {
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+
+	// Contact's first name
+	FirstName string `json:"first_name"`
+
+	// Optional. Contact's last name
+	LastName string `json:"last_name,omitempty"`
+
+	// Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+}
+
+// Represents the content of an invoice message to be sent as the result of an inline query.
+type InputInvoiceMessageContent struct {
+	// Product name, 1-32 characters
+	Title string `json:"title"`
+
+	// Product description, 1-255 characters
+	Description string `json:"description"`
+
+	// Bot-defined invoice payload, 1-128 bytes. This will not be
+	// displayed to the user, use it for your internal processes.
+	Payload string `json:"payload"`
+
+	// Optional. Payment provider token, obtained via @BotFather.
+	// Pass an empty string for payments in Telegram Stars.
+	ProviderToken string `json:"provider_token,omitempty"`
+
+	// Three-letter ISO 4217 currency code, see more on currencies.
+	// Pass “XTR” for payments in Telegram Stars.
+	Currency string `json:"currency"`
+
+	// Price breakdown, a JSON-serialized list of components (e.g.
+	// product price, tax, discount, delivery cost, delivery tax,
+	// bonus, etc.). Must contain exactly one item for payments in
+	// Telegram Stars.
+	Prices []LabeledPrice `json:"prices"`
+
+	// Optional. The maximum accepted amount for tips in the smallest
+	// units of the currency (integer, not float/double). Not supported
+	// for payments in Telegram Stars.
+	MaxTipAmount int `json:"max_tip_amount,omitempty"`
+
+	// Optional. A JSON-serialized array of suggested amounts of tip in
+	// the smallest units of the currency (integer, not float/double).
+	// At most 4 suggested tip amounts can be specified. The suggested
+	// tip amounts must be positive, passed in a strictly increased
+	// order and must not exceed max_tip_amount.
+	SuggestedTipAmounts []int `json:"suggested_tip_amounts,omitempty"`
+
+	// Optional. A JSON-serialized object for data about the invoice,
+	// which will be shared with the payment provider. A detailed
+	// description of the required fields should be provided by the
+	// payment provider.
+	ProviderData string `json:"provider_data,omitempty"`
+
+	// Optional. URL of the product photo for the invoice. Can be a
+	// photo of the goods or a marketing image for a service.
+	PhotoURL string `json:"photo_url,omitempty"`
+
+	// Optional. Photo size in bytes
+	PhotoSize int `json:"photo_size,omitempty"`
+
+	// Optional. Photo width
+	PhotoWidth int `json:"photo_width,omitempty"`
+
+	// Optional. Photo height
+	PhotoHeight int `json:"photo_height,omitempty"`
+
+	// Optional. Pass True if you require the user's full name to
+	// complete the order. Ignored for payments in Telegram Stars.
+	NeedName bool `json:"need_name,omitempty"`
+
+	// Optional. Pass True if you require the user's phone number to
+	// complete the order. Ignored for payments in Telegram Stars.
+	NeedPhoneNumber bool `json:"need_phone_number,omitempty"`
+
+	// Optional. Pass True if you require the user's email address to
+	// complete the order. Ignored for payments in Telegram Stars.
+	NeedEmail bool `json:"need_email,omitempty"`
+
+	// Optional. Pass True if you require the user's shipping address to
+	// complete the order. Ignored for payments in Telegram Stars.
+	NeedShippingAddress bool `json:"need_shipping_address,omitempty"`
+
+	// Optional. Pass True if the user's phone number should be sent
+	// to the provider. Ignored for payments in Telegram Stars.
+	SendPhoneNumberToProvider bool `json:"send_phone_number_to_provider,omitempty"`
+
+	// Optional. Pass True if the user's email address should be sent
+	// to the provider. Ignored for payments in Telegram Stars.
+	SendEmailToProvider bool `json:"send_email_to_provider,omitempty"`
+
+	// Optional. Pass True if the final price depends on the shipping
+	// method. Ignored for payments in Telegram Stars.
+	IsFlexible bool `json:"is_flexible,omitempty"`
+}
+
+// MarshalJSON encodes the variant that is set. The variants
+// have no type field, Telegram tells them apart by their fields.
+func (c InputMessageContent) MarshalJSON() ([]byte, error) {
+	switch {
+	case c.Text != nil:
+		return json.Marshal(c.Text)
+	case c.Location != nil:
+		return json.Marshal(c.Location)
+	case c.Venue != nil:
+		return json.Marshal(c.Venue)
+	case c.Contact != nil:
+		return json.Marshal(c.Contact)
+	case c.Invoice != nil:
+		return json.Marshal(c.Invoice)
+	default:
+		return nil, errors.New("input message content is empty")
+	}
+}
@@ -91,13 +91,13 @@ type KeyboardButton struct {
 	// a list of suitable users. Identifiers of selected
 	// users will be sent to the bot in a “users_shared”
 	// service message. Available in private chats only.
-	RequestUsers any `json:"request_users,omitempty"`
+	RequestUsers *KeyboardButtonRequestUsers `json:"request_users,omitempty"`
 
 	// Optional. If specified, pressing the button will
 	// open a list of suitable chats. Tapping on a chat
 	// will send its identifier to the bot in a “chat_shared”
 	// service message. Available in private chats only.
-	RequestChat any `json:"request_chat,omitempty"`
+	RequestChat *KeyboardButtonRequestChat `json:"request_chat,omitempty"`
 
 	// Optional. If True, the user's phone number will
 	// be sent as a contact when the button is pressed.
@@ -112,13 +112,13 @@ type KeyboardButton struct {
 	// Optional. If specified, the user will be asked
 	// to create a poll and send it to the bot when the
 	// button is pressed. Available in private chats only.
-	RequestPoll any `json:"request_poll,omitempty"`
+	RequestPoll *KeyboardButtonPollType `json:"request_poll,omitempty"`
 
 	// Optional. If specified, the described Web App
 	// will be launched when the button is pressed.
 	// The Web App will be able to send a “web_app_data”
 	// service message. Available in private chats only.
-	WebApp any `json:"web_app,omitempty"`
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
 }
 
 // This object represents an inline keyboard
@@ -155,12 +155,12 @@ type InlineKeyboardButton struct {
 	// using the method answerWebAppQuery (https://core.telegram.org/bots/api#answerwebappquery).
 	// Available only in private chats between a user and the bot. Not
 	// supported for messages sent on behalf of a Telegram Business account.
-	WebApp any `json:"web_app,omitempty"`
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
 
 	// Optional. An HTTPS URL used to automatically authorize the user.
 	// Can be used as a replacement for the Telegram Login Widget.
 	// (https://core.telegram.org/widgets/login)
-	LoginUrl any `json:"login_url,omitempty"`
+	LoginUrl *LoginUrl `json:"login_url,omitempty"`
 
 	// Optional. If set, pressing the button will prompt the user to
 	// select one of their chats, open that chat and insert the bot's
@@ -185,18 +185,18 @@ type InlineKeyboardButton struct {
 	// username and the specified inline query in the input field. Not supported
 	// for messages sent in channel direct messages chats and on behalf of a
 	// Telegram Business account.
-	SwitchInlineQueryChosenChat any `json:"switch_inline_query_chosen_chat,omitempty"`
+	SwitchInlineQueryChosenChat *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat,omitempty"`
 
 	// Optional. Description of the button that copies
 	// the specified text to the clipboard.
-	CopyText any `json:"copy_text,omitempty"`
+	CopyText *CopyTextButton `json:"copy_text,omitempty"`
 
 	// Optional. Description of the game that will be
 	// launched when the user presses the button.
 	//
 	// NOTE: This type of button must always be the
 	// first button in the first row.
-	CallbackGame any `json:"callback_game,omitempty"`
+	CallbackGame *CallbackGame `json:"callback_game,omitempty"`
 
 	// Optional. Specify True, to send a Pay button. Substrings “⭐” and
 	// “XTR” in the buttons's text will be replaced with a Telegram Star icon.
@@ -250,3 +250,147 @@ type ForceReply struct {
 	// sender of the original message.
 	Selective bool `json:"selective,omitempty"`
 }
+
+// This object defines the criteria used to request suitable users.
+// Information about the selected users will be shared with the bot
+// when the corresponding button is pressed.
+type KeyboardButtonRequestUsers struct {
+	// Signed 32-bit identifier of the request that will be received
+	// back in the UsersShared object. Must be unique within the message
+	RequestID int32 `json:"request_id"`
+
+	// Optional. Pass True to request bots, pass False to request
+	// regular users. If not specified, no additional restrictions are applied.
+	UserIsBot *bool `json:"user_is_bot,omitempty"`
+
+	// Optional. Pass True to request premium users, pass False to
+	// request non-premium users. If not specified, no additional
+	// restrictions are applied.
+	UserIsPremium *bool `json:"user_is_premium,omitempty"`
+
+	// Optional. The maximum number of users to be selected; 1-10. Defaults to 1.
+	MaxQuantity int `json:"max_quantity,omitempty"`
+
+	// Optional. Pass True to request the users' first and last names
+	RequestName bool `json:"request_name,omitempty"`
+
+	// Optional. Pass True to request the users' usernames
+	RequestUsername bool `json:"request_username,omitempty"`
+
+	// Optional. Pass True to request the users' photos
+	RequestPhoto bool `json:"request_photo,omitempty"`
+}
+
+// This object defines the criteria used to request a suitable chat.
+// Information about the selected chat will be shared with the bot
+// when the corresponding button is pressed. The bot will be granted
+// requested rights in the chat if appropriate.
+type KeyboardButtonRequestChat struct {
+	// Signed 32-bit identifier of the request, which will be received
+	// back in the ChatShared object. Must be unique within the message
+	RequestID int32 `json:"request_id"`
+
+	// Pass True to request a channel chat, pass
+	// False to request a group or a supergroup chat.
+	ChatIsChannel bool `json:"chat_is_channel"`
+
+	// Optional. Pass True to request a forum supergroup, pass False
+	// to request a non-forum chat. If not specified, no additional
+	// restrictions are applied.
+	ChatIsForum *bool `json:"chat_is_forum,omitempty"`
+
+	// Optional. Pass True to request a supergroup or a channel with
+	// a username, pass False to request a chat without a username.
+	// If not specified, no additional restrictions are applied.
+	ChatHasUsername *bool `json:"chat_has_username,omitempty"`
+
+	// Optional. Pass True to request a chat owned by the user.
+	// Otherwise, no additional restrictions are applied.
+	ChatIsCreated bool `json:"chat_is_created,omitempty"`
+
+	// Optional. A JSON-serialized object listing the required
+	// administrator rights of the user in the chat. The rights
+	// must be a superset of bot_administrator_rights. If not
+	// specified, no additional restrictions are applied.
+	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
+
+	// Optional. A JSON-serialized object listing the required
+	// administrator rights of the bot in the chat. The rights must
+	// be a subset of user_administrator_rights. If not specified,
+	// no additional restrictions are applied.
+	BotAdministratorRights *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
+
+	// Optional. Pass True to request a chat with the bot as a member.
+	// Otherwise, no additional restrictions are applied.
+	BotIsMember bool `json:"bot_is_member,omitempty"`
+
+	// Optional. Pass True to request the chat's title
+	RequestTitle bool `json:"request_title,omitempty"`
+
+	// Optional. Pass True to request the chat's username
+	RequestUsername bool `json:"request_username,omitempty"`
+
+	// Optional. Pass True to request the chat's photo
+	RequestPhoto bool `json:"request_photo,omitempty"`
+}
+
+// This object represents type of a poll, which is allowed to
+// be created and sent when the corresponding button is pressed.
+type KeyboardButtonPollType struct {
+	// Optional. If quiz is passed, the user will be allowed to create
+	// only polls in the quiz mode. If regular is passed, only regular
+	// polls will be allowed. Otherwise, the user will be allowed to
+	// create a poll of any type.
+	Type string `json:"type,omitempty"`
+}
+
+// This object represents a parameter of the inline keyboard button
+// used to automatically authorize a user. Serves as a great replacement
+// for the Telegram Login Widget when the user is coming from Telegram.
+type LoginUrl struct {
+	// An HTTPS URL to be opened with user authorization data
+	// added to the query string when the button is pressed.
+	// If the user refuses to provide authorization data, the
+	// original URL without information about the user will be opened.
+	Url string `json:"url"`
+
+	// Optional. New text of the button in forwarded messages.
+	ForwardText string `json:"forward_text,omitempty"`
+
+	// Optional. Username of a bot, which will be used for user
+	// authorization. If not specified, the current bot's username
+	// will be assumed. The url's domain must be the same as the
+	// domain linked with the bot.
+	BotUsername string `json:"bot_username,omitempty"`
+
+	// Optional. Pass True to request the permission
+	// for your bot to send messages to the user.
+	RequestWriteAccess bool `json:"request_write_access,omitempty"`
+}
+
+// This object represents an inline button that switches the current
+// user to inline mode in a chosen chat, with an optional default inline query.
+type SwitchInlineQueryChosenChat struct {
+	// Optional. The default inline query to be inserted in the input
+	// field. If left empty, only the bot's username will be inserted
+	Query string `json:"query,omitempty"`
+
+	// Optional. True, if private chats with users can be chosen
+	AllowUserChats bool `json:"allow_user_chats,omitempty"`
+
+	// Optional. True, if private chats with bots can be chosen
+	AllowBotChats bool `json:"allow_bot_chats,omitempty"`
+
+	// Optional. True, if group and supergroup chats can be chosen
+	AllowGroupChats bool `json:"allow_group_chats,omitempty"`
+
+	// Optional. True, if channel chats can be chosen
+	AllowChannelChats bool `json:"allow_channel_chats,omitempty"`
+}
+
+// This object represents an inline keyboard button
+// that copies specified text to the clipboard.
+type CopyTextButton struct {
+	// The text to be copied to the clipboard; 1-256 characters
+	Text string `json:"text"`
+}
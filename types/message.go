@@ -1,5 +1,7 @@
 package types
 
+import "encoding/json"
+
 type Message struct {
 	// Unique message identifier inside this chat.
 	// In specific instances (e.g., message containing
@@ -8,7 +10,7 @@ type Message struct {
 	// sending it immediately. In such cases, this
 	// field will be 0 and the relevant message will
 	// be unusable until it is actually sent
-	MessageID uint `json:"message_id"`
+	MessageID int `json:"message_id"`
 
 	// Optional. Unique identifier of a message thread
 	// to which the message belongs; for supergroups only
@@ -248,7 +250,7 @@ type Message struct {
 	// interpreting it. But it has at most 52 significant
 	// bits, so a signed 64-bit integer or double-precision
 	// float type are safe for storing this identifier.
-	MigrateToChatID int `json:"migrate_to_chat_id,omitempty"`
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
 
 	// Optional. The supergroup has been migrated from a group
 	// with the specified identifier. This number may have more
@@ -257,7 +259,7 @@ type Message struct {
 	// But it has at most 52 significant bits, so a signed
 	// 64-bit integer or double-precision float type are safe
 	// for storing this identifier.
-	MigrateFromChatID int `json:"migrate_from_chat_id,omitempty"`
+	MigrateFromChatID int64 `json:"migrate_from_chat_id,omitempty"`
 
 	// Optional. Specified message was pinned. Note that the
 	// Message object in this field will not contain further
@@ -415,12 +417,71 @@ type InaccessibleMessage struct {
 }
 
 // This object describes a message that can be
-// inaccessible to the bot. It can be one of
+// inaccessible to the bot. Exactly one of the
+// embedded messages is set.
 type MaybeInaccessibleMessage struct {
 	*Message
 	*InaccessibleMessage
 }
 
+func (m *MaybeInaccessibleMessage) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Date int `json:"date"`
+	}
+
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	*m = MaybeInaccessibleMessage{}
+
+	if probe.Date == 0 {
+		return unmarshalVariant(data, &m.InaccessibleMessage)
+	}
+
+	return unmarshalVariant(data, &m.Message)
+}
+
+func (m MaybeInaccessibleMessage) MarshalJSON() ([]byte, error) {
+	switch {
+	case m.Message != nil:
+		return json.Marshal(m.Message)
+	case m.InaccessibleMessage != nil:
+		return json.Marshal(m.InaccessibleMessage)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// IsAccessible reports whether the message is available to the bot.
+func (m MaybeInaccessibleMessage) IsAccessible() bool {
+	return m.Message != nil
+}
+
+// Chat returns the chat the message belongs to.
+func (m MaybeInaccessibleMessage) Chat() *Chat {
+	switch {
+	case m.Message != nil:
+		return m.Message.Chat
+	case m.InaccessibleMessage != nil:
+		return m.InaccessibleMessage.Chat
+	default:
+		return nil
+	}
+}
+
+// ID returns the unique message identifier inside the chat.
+func (m MaybeInaccessibleMessage) ID() int {
+	switch {
+	case m.Message != nil:
+		return m.Message.MessageID
+	case m.InaccessibleMessage != nil:
+		return m.InaccessibleMessage.MessageID
+	default:
+		return 0
+	}
+}
+
 type MessageEntity struct {
 	// Type of the entity. Currently, can be “mention” (@username),
 	// “hashtag” (#hashtag or #hashtag@chatusername), “cashtag”
@@ -462,8 +523,6 @@ type MessageAutoDeleteTimerChanged struct {
 	MessageAutoDeleteTime int `json:"message_auto_delete_time"`
 }
 
-type MessageOrigin struct{}
-
 type DirectMessagesTopic struct {
 	// Unique identifier of the topic
 	TopicID int `json:"topic_id"`
@@ -516,3 +575,33 @@ type PaidMessagePriceChanged struct {
 	// supergroup chat for each sent message
 	PaidMessageStarCount int `json:"paid_message_star_count"`
 }
+
+// EditedMessage is the result of editing a message. It holds
+// the edited Message, or nil if an inline message was edited,
+// in which case Telegram returns True instead.
+type EditedMessage struct {
+	Message *Message
+}
+
+func (e *EditedMessage) UnmarshalJSON(data []byte) error {
+	*e = EditedMessage{}
+
+	if string(data) == "true" {
+		return nil
+	}
+
+	return unmarshalVariant(data, &e.Message)
+}
+
+func (e EditedMessage) MarshalJSON() ([]byte, error) {
+	if e.Message == nil {
+		return []byte("true"), nil
+	}
+
+	return json.Marshal(e.Message)
+}
+
+// IsInline reports whether an inline message was edited.
+func (e EditedMessage) IsInline() bool {
+	return e.Message == nil
+}
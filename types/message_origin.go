@@ -0,0 +1,137 @@
+package types
+
+import "encoding/json"
+
+const (
+	MessageOriginTypeUser       = "user"
+	MessageOriginTypeHiddenUser = "hidden_user"
+	MessageOriginTypeChat       = "chat"
+	MessageOriginTypeChannel    = "channel"
+)
+
+// This object describes the origin of a message.
+// Exactly one of the fields is set.
+type MessageOrigin struct {
+	User       *MessageOriginUser
+	HiddenUser *MessageOriginHiddenUser
+	Chat       *MessageOriginChat
+	Channel    *MessageOriginChannel
+
+	// Optional. Raw JSON of a variant unknown
+	// to this version of the library
+	Unknown json.RawMessage
+}
+
+// The message was originally sent by a known user.
+type MessageOriginUser struct {
+	// Type of the message origin, always “user”
+	Type string `json:"type"`
+
+	// Date the message was sent originally in Unix time
+	Date int `json:"date"`
+
+	// User that sent the message originally
+	SenderUser User `json:"sender_user"`
+}
+
+// The message was originally sent by an unknown user.
+type MessageOriginHiddenUser struct {
+	// Type of the message origin, always “hidden_user”
+	Type string `json:"type"`
+
+	// Date the message was sent originally in Unix time
+	Date int `json:"date"`
+
+	// Name of the user that sent the message originally
+	SenderUserName string `json:"sender_user_name"`
+}
+
+// The message was originally sent on behalf of a chat to a group chat.
+type MessageOriginChat struct {
+	// Type of the message origin, always “chat”
+	Type string `json:"type"`
+
+	// Date the message was sent originally in Unix time
+	Date int `json:"date"`
+
+	// Chat that sent the message originally
+	SenderChat Chat `json:"sender_chat"`
+
+	// Optional. For messages originally sent by an anonymous
+	// chat administrator, original message author signature
+	AuthorSignature string `json:"author_signature,omitempty"`
+}
+
+// The message was originally sent to a channel chat.
+type MessageOriginChannel struct {
+	// Type of the message origin, always “channel”
+	Type string `json:"type"`
+
+	// Date the message was sent originally in Unix time
+	Date int `json:"date"`
+
+	// Channel chat to which the message was originally sent
+	Chat Chat `json:"chat"`
+
+	// Unique message identifier inside the chat
+	MessageID int `json:"message_id"`
+
+	// Optional. Signature of the original post author
+	AuthorSignature string `json:"author_signature,omitempty"`
+}
+
+func (o *MessageOrigin) UnmarshalJSON(data []byte) error {
+	kind, err := unionKind(data, "type")
+	if err != nil {
+		return err
+	}
+
+	*o = MessageOrigin{}
+
+	switch kind {
+	case MessageOriginTypeUser:
+		return unmarshalVariant(data, &o.User)
+	case MessageOriginTypeHiddenUser:
+		return unmarshalVariant(data, &o.HiddenUser)
+	case MessageOriginTypeChat:
+		return unmarshalVariant(data, &o.Chat)
+	case MessageOriginTypeChannel:
+		return unmarshalVariant(data, &o.Channel)
+	default:
+		o.Unknown = unknownVariant(data)
+		return nil
+	}
+}
+
+func (o MessageOrigin) MarshalJSON() ([]byte, error) {
+	switch {
+	case o.User != nil:
+		return json.Marshal(o.User)
+	case o.HiddenUser != nil:
+		return json.Marshal(o.HiddenUser)
+	case o.Chat != nil:
+		return json.Marshal(o.Chat)
+	case o.Channel != nil:
+		return json.Marshal(o.Channel)
+	case o.Unknown != nil:
+		return o.Unknown, nil
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// Date returns the date the message was sent originally in Unix time.
+func (o MessageOrigin) Date() int {
+	switch {
+	case o.User != nil:
+		return o.User.Date
+	case o.HiddenUser != nil:
+		return o.HiddenUser.Date
+	case o.Chat != nil:
+		return o.Chat.Date
+	case o.Channel != nil:
+		return o.Channel.Date
+	default:
+		return 0
+	}
+}
@@ -1,5 +1,7 @@
 package types
 
+import "encoding/json"
+
 // Describes the paid media added to a message.
 type PaidMediaInfo struct {
 	// The number of Telegram Stars that must be paid to buy access to the media
@@ -9,12 +11,21 @@ type PaidMediaInfo struct {
 	PaidMedia []PaidMedia `json:"paid_media"`
 }
 
-// This object describes paid media. Currently, it can be one of
-type PaidMedia interface {
-	// PaidMediaPreview
-	// PaidMediaPhoto
-	// PaidMediaVideo
-	getPaidMediaType() string
+const (
+	PaidMediaTypePreview = "preview"
+	PaidMediaTypePhoto   = "photo"
+	PaidMediaTypeVideo   = "video"
+)
+
+// This object describes paid media. Exactly one of the fields is set.
+type PaidMedia struct {
+	Preview *PaidMediaPreview
+	Photo   *PaidMediaPhoto
+	Video   *PaidMediaVideo
+
+	// Optional. Raw JSON of a variant unknown
+	// to this version of the library
+	Unknown json.RawMessage
 }
 
 // The paid media isn't available before the payment.
@@ -49,3 +60,39 @@ type PaidMediaVideo struct {
 	// The video
 	Video *Video `json:"video"`
 }
+
+func (m *PaidMedia) UnmarshalJSON(data []byte) error {
+	kind, err := unionKind(data, "type")
+	if err != nil {
+		return err
+	}
+
+	*m = PaidMedia{}
+
+	switch kind {
+	case PaidMediaTypePreview:
+		return unmarshalVariant(data, &m.Preview)
+	case PaidMediaTypePhoto:
+		return unmarshalVariant(data, &m.Photo)
+	case PaidMediaTypeVideo:
+		return unmarshalVariant(data, &m.Video)
+	default:
+		m.Unknown = unknownVariant(data)
+		return nil
+	}
+}
+
+func (m PaidMedia) MarshalJSON() ([]byte, error) {
+	switch {
+	case m.Preview != nil:
+		return json.Marshal(m.Preview)
+	case m.Photo != nil:
+		return json.Marshal(m.Photo)
+	case m.Video != nil:
+		return json.Marshal(m.Video)
+	case m.Unknown != nil:
+		return m.Unknown, nil
+	default:
+		return []byte("null"), nil
+	}
+}
@@ -1,5 +1,12 @@
 package types
 
+import "time"
+
+const (
+	PollTypeRegular = "regular"
+	PollTypeQuiz    = "quiz"
+)
+
 type Poll struct {
 	ID string `json:"id"` // Unique poll identifier
 
@@ -49,6 +56,12 @@ type Poll struct {
 	CloseDate int `json:"close_date,omitempty"`
 }
 
+// Closes returns the time when the poll will be automatically
+// closed, or zero time if the poll has no close date.
+func (p Poll) Closes() time.Time {
+	return unixTime(p.CloseDate)
+}
+
 type PollOption struct {
 	// Option text, 1-100 characters
 	Text string `json:"text"`
@@ -61,6 +74,22 @@ type PollOption struct {
 	VoterCount int `json:"voter_count"`
 }
 
+// This object contains information about one answer option in a poll to be sent.
+type InputPollOption struct {
+	// Option text, 1-100 characters
+	Text string `json:"text"`
+
+	// Optional. Mode for parsing entities in the text. See formatting
+	// options for more details. Currently, only custom emoji entities
+	// are allowed
+	TextParseMode ParseMode `json:"text_parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear
+	// in the poll option text. It can be specified instead of
+	// text_parse_mode
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+}
+
 type PollAnswer struct {
 	// Unique poll identifier
 	PollID string `json:"poll_id"`
@@ -0,0 +1,160 @@
+package types
+
+import "encoding/json"
+
+const (
+	ReactionTypeTypeEmoji       = "emoji"
+	ReactionTypeTypeCustomEmoji = "custom_emoji"
+	ReactionTypeTypePaid        = "paid"
+)
+
+// This object describes the type of a reaction.
+// Exactly one of the fields is set.
+type ReactionType struct {
+	Emoji       *ReactionTypeEmoji
+	CustomEmoji *ReactionTypeCustomEmoji
+	Paid        *ReactionTypePaid
+
+	// Optional. Raw JSON of a variant unknown
+	// to this version of the library
+	Unknown json.RawMessage
+}
+
+// The reaction is based on an emoji.
+type ReactionTypeEmoji struct {
+	// Type of the reaction, always “emoji”
+	Type string `json:"type"`
+
+	// Reaction emoji. Currently, it can be one of "❤", "👍",
+	// "👎", "🔥", "🥰", "👏", "😁", "🤔", "🤯", "😱", "🤬", "😢", ...
+	// (https://core.telegram.org/bots/api#reactiontypeemoji)
+	Emoji string `json:"emoji"`
+}
+
+// The reaction is based on a custom emoji.
+type ReactionTypeCustomEmoji struct {
+	// Type of the reaction, always “custom_emoji”
+	Type string `json:"type"`
+
+	// Custom emoji identifier
+	CustomEmojiID string `json:"custom_emoji_id"`
+}
+
+// The reaction is paid.
+type ReactionTypePaid struct {
+	// Type of the reaction, always “paid”
+	Type string `json:"type"`
+}
+
+// EmojiReaction returns a reaction based on the emoji.
+func EmojiReaction(emoji string) ReactionType {
+	return ReactionType{Emoji: &ReactionTypeEmoji{Emoji: emoji}}
+}
+
+// CustomEmojiReaction returns a reaction based on the custom emoji.
+func CustomEmojiReaction(customEmojiID string) ReactionType {
+	return ReactionType{CustomEmoji: &ReactionTypeCustomEmoji{CustomEmojiID: customEmojiID}}
+}
+
+// Is reports whether the reaction is based on the emoji.
+func (r ReactionType) Is(emoji string) bool {
+	return r.Emoji != nil && r.Emoji.Emoji == emoji
+}
+
+func (r *ReactionType) UnmarshalJSON(data []byte) error {
+	kind, err := unionKind(data, "type")
+	if err != nil {
+		return err
+	}
+
+	*r = ReactionType{}
+
+	switch kind {
+	case ReactionTypeTypeEmoji:
+		return unmarshalVariant(data, &r.Emoji)
+	case ReactionTypeTypeCustomEmoji:
+		return unmarshalVariant(data, &r.CustomEmoji)
+	case ReactionTypeTypePaid:
+		return unmarshalVariant(data, &r.Paid)
+	default:
+		r.Unknown = unknownVariant(data)
+		return nil
+	}
+}
+
+// MarshalJSON fills in the type of the reaction,
+// so it can be omitted when building reactions.
+func (r ReactionType) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.Emoji != nil:
+		v := *r.Emoji
+		v.Type = ReactionTypeTypeEmoji
+		return json.Marshal(v)
+
+	case r.CustomEmoji != nil:
+		v := *r.CustomEmoji
+		v.Type = ReactionTypeTypeCustomEmoji
+		return json.Marshal(v)
+
+	case r.Paid != nil:
+		return json.Marshal(ReactionTypePaid{Type: ReactionTypeTypePaid})
+
+	case r.Unknown != nil:
+		return r.Unknown, nil
+
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// Represents a reaction added to a message
+// along with the number of times it was added.
+type ReactionCount struct {
+	// Type of the reaction
+	Type ReactionType `json:"type"`
+
+	// Number of times the reaction was added
+	TotalCount int `json:"total_count"`
+}
+
+// This object represents a change of a reaction on a message performed by a user.
+type MessageReactionUpdated struct {
+	// The chat containing the message the user reacted to
+	Chat Chat `json:"chat"`
+
+	// Unique identifier of the message inside the chat
+	MessageID int `json:"message_id"`
+
+	// Optional. The user that changed the reaction,
+	// if the user isn't anonymous
+	User *User `json:"user,omitempty"`
+
+	// Optional. The chat on behalf of which the
+	// reaction was changed, if the user is anonymous
+	ActorChat *Chat `json:"actor_chat,omitempty"`
+
+	// Date of the change in Unix time
+	Date int `json:"date"`
+
+	// Previous list of reaction types that were set by the user
+	OldReaction []ReactionType `json:"old_reaction"`
+
+	// New list of reaction types that have been set by the user
+	NewReaction []ReactionType `json:"new_reaction"`
+}
+
+// This object represents reaction changes on
+// a message with anonymous reactions.
+type MessageReactionCountUpdated struct {
+	// The chat containing the message
+	Chat Chat `json:"chat"`
+
+	// Unique message identifier inside the chat
+	MessageID int `json:"message_id"`
+
+	// Date of the change in Unix time
+	Date int `json:"date"`
+
+	// List of reactions that are present on the message
+	Reactions []ReactionCount `json:"reactions"`
+}
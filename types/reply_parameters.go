@@ -11,7 +11,7 @@ type ReplyParameters struct {
 	// of the channel (in the format @channelusername). Not supported
 	// for messages sent on behalf of a business account and messages
 	// from channel direct messages chats.
-	ChatID int `json:"chat_id,omitempty"`
+	ChatID ChatID `json:"chat_id,omitzero"`
 
 	// Optional. Pass True if the message should be sent even if the
 	// specified message to be replied to is not found. Always False for
@@ -28,7 +28,7 @@ type ReplyParameters struct {
 
 	// Optional. Mode for parsing entities in the quote. See formatting options
 	// (https://core.telegram.org/bots/api#formatting-options) for more details.
-	QuoteParseMode string `json:"quote_parse_mode,omitempty"`
+	QuoteParseMode ParseMode `json:"quote_parse_mode,omitempty"`
 
 	// Optional. A JSON-serialized list of special entities that appear
 	// in the quote. It can be specified instead of quote_parse_mode.
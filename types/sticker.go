@@ -100,3 +100,13 @@ type Dice struct {
 	// for “🏀” and “⚽” base emoji, 1-64 for “🎰” base emoji
 	Value int `json:"value"`
 }
+
+// Base emoji of dice, see Dice.Emoji.
+const (
+	DiceEmojiDice        = "🎲"
+	DiceEmojiDarts       = "🎯"
+	DiceEmojiBowling     = "🎳"
+	DiceEmojiBasketball  = "🏀"
+	DiceEmojiFootball    = "⚽"
+	DiceEmojiSlotMachine = "🎰"
+)
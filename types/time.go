@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// unixTime converts a date in Unix time,
+// where 0 stands for no date, to time.Time.
+func unixTime(date int) time.Time {
+	if date == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(date), 0)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// unionKind reads the string value of the key that tells
+// which variant of a polymorphic object the data holds.
+func unionKind(data []byte, key string) (string, error) {
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+
+	raw, ok := fields[key]
+	if !ok {
+		return "", fmt.Errorf("field %q is missing", key)
+	}
+
+	var kind string
+	if err := json.Unmarshal(raw, &kind); err != nil {
+		return "", fmt.Errorf("field %q: %w", key, err)
+	}
+
+	return kind, nil
+}
+
+// unmarshalVariant allocates the variant, decodes data into it
+// and stores the pointer in dst.
+func unmarshalVariant[V any](data []byte, dst **V) error {
+	v := new(V)
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	*dst = v
+	return nil
+}
+
+// unknownVariant keeps a copy of the data of a variant unknown
+// to this version of the library, so new variants added by
+// Telegram don't fail decoding of the whole update.
+func unknownVariant(data []byte) json.RawMessage {
+	return slices.Clone(data)
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnmarshalUnknownVariants(t *testing.T) {
+	const batch = `[
+		{"update_id": 1, "message": {"message_id": 1, "date": 0, "chat": {"id": 1, "type": "private"},
+			"forward_origin": {"type": "future_origin", "date": 0}}},
+		{"update_id": 2, "message_reaction": {"chat": {"id": 1, "type": "private"}, "message_id": 1, "date": 0,
+			"old_reaction": [], "new_reaction": [{"type": "emoji", "emoji": "👍"}, {"type": "future_reaction"}]}},
+		{"update_id": 3, "message": {"message_id": 2, "date": 0, "chat": {"id": 1, "type": "private"},
+			"chat_background_set": {"type": {"type": "future_background"}}}}
+	]`
+
+	var updates []Update
+	if err := json.Unmarshal([]byte(batch), &updates); err != nil {
+		t.Fatalf("unmarshal updates: %v", err)
+	}
+
+	if len(updates) != 3 {
+		t.Fatalf("got %d updates, want 3", len(updates))
+	}
+
+	origin := updates[0].Message.ForwardOrigin
+	if origin.User != nil || origin.HiddenUser != nil || origin.Chat != nil || origin.Channel != nil {
+		t.Errorf("unknown origin decoded into a known variant: %+v", origin)
+	}
+	if origin.Unknown == nil {
+		t.Error("unknown origin lost its raw JSON")
+	}
+
+	reactions := updates[1].MessageReaction.NewReaction
+	if len(reactions) != 2 || reactions[0].Emoji == nil {
+		t.Fatalf("known reaction not decoded: %+v", reactions)
+	}
+	if r := reactions[1]; r.Emoji != nil || r.CustomEmoji != nil || r.Paid != nil || r.Unknown == nil {
+		t.Errorf("unknown reaction decoded as %+v", r)
+	}
+
+	background := updates[2].Message.ChatBackgroundSet.Type
+	if background.Fill != nil || background.Wallpaper != nil ||
+		background.Pattern != nil || background.ChatTheme != nil {
+		t.Errorf("unknown background decoded into a known variant: %+v", background)
+	}
+	if background.Unknown == nil {
+		t.Error("unknown background lost its raw JSON")
+	}
+}
+
+func TestMarshalUnknownVariant(t *testing.T) {
+	const raw = `{"type":"future_reaction","value":1}`
+
+	var r ReactionType
+	if err := json.Unmarshal([]byte(raw), &r); err != nil {
+		t.Fatalf("unmarshal reaction: %v", err)
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("marshal reaction: %v", err)
+	}
+
+	if string(data) != raw {
+		t.Errorf("got %s, want %s", data, raw)
+	}
+}
+
+func TestUnmarshalUnknownChatMemberStatus(t *testing.T) {
+	const raw = `{"status": "future_status", "user": {"id": 42, "is_bot": false, "first_name": "A"}}`
+
+	var m ChatMember
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		t.Fatalf("unmarshal chat member: %v", err)
+	}
+
+	if m.Status() != "future_status" {
+		t.Errorf("got status %q, want %q", m.Status(), "future_status")
+	}
+
+	if u := m.User(); u == nil || u.ID != 42 {
+		t.Errorf("got user %+v, want id 42", u)
+	}
+
+	if m.IsMember() || m.IsAdmin() {
+		t.Error("unknown member reported as present in the chat")
+	}
+}
+
+func TestUnmarshalUnknownBotCommandScope(t *testing.T) {
+	const raw = `{"type":"future_scope","chat_id":1}`
+
+	var s BotCommandScope
+	if err := json.Unmarshal([]byte(raw), &s); err != nil {
+		t.Fatalf("unmarshal scope: %v", err)
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("marshal scope: %v", err)
+	}
+
+	// An unknown scope must not turn into the default one
+	if string(data) != raw {
+		t.Errorf("got %s, want %s", data, raw)
+	}
+}
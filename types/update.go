@@ -40,21 +40,21 @@ type Update struct {
 	EditedBusinessMessage *Message `json:"edited_business_message,omitempty"`
 
 	// Optional. Messages were deleted from a connected business account
-	DeletedBusinessMessages *any `json:"deleted_business_messages,omitempty"`
+	DeletedBusinessMessages *BusinessMessagesDeleted `json:"deleted_business_messages,omitempty"`
 
 	// Optional. A reaction to a message was changed by a user.
 	// The bot must be an administrator in the chat and must
 	// explicitly specify "message_reaction" in the list of
 	// allowed_updates to receive these updates. The update
 	// isn't received for reactions set by bots.
-	MessageReaction *any `json:"message_reaction,omitempty"`
+	MessageReaction *MessageReactionUpdated `json:"message_reaction,omitempty"`
 
 	// Optional. Reactions to a message with anonymous reactions
 	// were changed. The bot must be an administrator in the chat
 	// and must explicitly specify "message_reaction_count" in the
 	// list of allowed_updates to receive these updates. The updates
 	// are grouped and can be sent with delay up to a few minutes.
-	MessageReactionCount *any `json:"message_reaction_count,omitempty"`
+	MessageReactionCount *MessageReactionCountUpdated `json:"message_reaction_count,omitempty"`
 
 	// Optional. New incoming inline query
 	InlineQuery *InlineQuery `json:"inline_query,omitempty"`
@@ -7,7 +7,7 @@ type User struct {
 	// defects in interpreting it. But it has at most 52
 	// significant bits, so a 64-bit integer or double-precision
 	// float type are safe for storing this identifier.
-	ID int `json:"id"`
+	ID int64 `json:"id"`
 
 	// True, if this user is a bot
 	IsBot bool `json:"is_bot"`
@@ -64,7 +64,7 @@ type Contact struct {
 	// defects in interpreting it. But it has at most 52
 	// significant bits, so a 64-bit integer or double-precision
 	// float type are safe for storing this identifier.
-	UserID int `json:"user_id,omitempty"`
+	UserID int64 `json:"user_id,omitempty"`
 
 	// Optional. Additional data about the contact in the form of a vCard
 	// (https://en.wikipedia.org/wiki/VCard)
@@ -82,7 +82,7 @@ type SharedUser struct {
 	// identifiers. The bot may not have access to the user and
 	// could be unable to use this identifier, unless the user is
 	// already known to the bot by some other means.
-	UserID int `json:"user_id"`
+	UserID int64 `json:"user_id"`
 
 	// Optional. First name of the user, if the name was requested by the bot
 	FirstName string `json:"first_name,omitempty"`
@@ -161,3 +161,15 @@ type Venue struct {
 	// (https://developers.google.com/maps/documentation/places/web-service/legacy/supported_types)
 	GooglePlaceType string `json:"google_place_type,omitempty"`
 }
+
+// Describes the birthdate of a user.
+type Birthdate struct {
+	// Day of the user's birth; 1-31
+	Day int `json:"day"`
+
+	// Month of the user's birth; 1-12
+	Month int `json:"month"`
+
+	// Optional. Year of the user's birth
+	Year int `json:"year,omitempty"`
+}
@@ -12,3 +12,11 @@ type WebAppData struct {
 	// arbitrary data in this field.
 	ButtonText string `json:"button_text"`
 }
+
+// Describes a Web App (https://core.telegram.org/bots/webapps).
+type WebAppInfo struct {
+	// An HTTPS URL of a Web App to be opened with additional
+	// data as specified in Initializing Web Apps
+	// (https://core.telegram.org/bots/webapps#initializing-mini-apps)
+	Url string `json:"url"`
+}